@@ -0,0 +1,239 @@
+package goholidays
+
+import "time"
+
+// WeekendHolidayCount returns the number of holidays within a date range that fall on a Saturday or Sunday
+func (c *Country) WeekendHolidayCount(start, end time.Time) int {
+	count := 0
+	for date := range c.HolidaysForDateRange(start, end) {
+		if weekday := date.Weekday(); weekday == time.Saturday || weekday == time.Sunday {
+			count++
+		}
+	}
+	return count
+}
+
+// MonthlyHolidayCounts returns the number of holidays in each month of a year
+func (c *Country) MonthlyHolidayCounts(year int) map[time.Month]int {
+	counts := make(map[time.Month]int, 12)
+	for month := time.January; month <= time.December; month++ {
+		counts[month] = 0
+	}
+	for date := range c.HolidaysForYear(year) {
+		counts[date.Month()]++
+	}
+	return counts
+}
+
+// BusiestMonth returns the month with the most holidays in a year and its count.
+// Ties are resolved in favour of the earlier month.
+func (c *Country) BusiestMonth(year int) (time.Month, int) {
+	counts := c.MonthlyHolidayCounts(year)
+	busiest := time.January
+	for month := time.February; month <= time.December; month++ {
+		if counts[month] > counts[busiest] {
+			busiest = month
+		}
+	}
+	return busiest, counts[busiest]
+}
+
+// LeanestMonth returns the month with the fewest holidays in a year and its count.
+// Ties are resolved in favour of the earlier month.
+func (c *Country) LeanestMonth(year int) (time.Month, int) {
+	counts := c.MonthlyHolidayCounts(year)
+	leanest := time.January
+	for month := time.February; month <= time.December; month++ {
+		if counts[month] < counts[leanest] {
+			leanest = month
+		}
+	}
+	return leanest, counts[leanest]
+}
+
+// PartitionByReligious splits the holidays of a year into religious holidays and all others
+func (c *Country) PartitionByReligious(year int) (religious, secular map[time.Time]*Holiday) {
+	religious = make(map[time.Time]*Holiday)
+	secular = make(map[time.Time]*Holiday)
+
+	for date, holiday := range c.HolidaysForYear(year) {
+		if canonicalCategory(holiday.Category) == CategoryReligious {
+			religious[date] = holiday
+		} else {
+			secular[date] = holiday
+		}
+	}
+
+	return religious, secular
+}
+
+// holidaysNamed returns every holiday in a year whose name matches, including holidays
+// that share their date with another holiday
+func (c *Country) holidaysNamed(year int, name string) []*Holiday {
+	loaded, loadedExtras := c.loadYearWithExtras(year)
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var matches []*Holiday
+	for _, holiday := range loaded {
+		if holiday.Name == name {
+			matches = append(matches, holiday)
+		}
+	}
+	for _, extras := range loadedExtras {
+		for _, holiday := range extras {
+			if holiday.Name == name {
+				matches = append(matches, holiday)
+			}
+		}
+	}
+	return matches
+}
+
+// HolidayWeekdayDistribution counts how often the named holiday falls on each weekday
+// over the years start to end inclusive
+func (c *Country) HolidayWeekdayDistribution(name string, start, end int) map[time.Weekday]int {
+	distribution := make(map[time.Weekday]int)
+	for year := start; year <= end; year++ {
+		for _, holiday := range c.holidaysNamed(year, name) {
+			distribution[holiday.Date.Weekday()]++
+		}
+	}
+	return distribution
+}
+
+// HolidayStats aggregates the holidays of a year, one per date as HolidaysForYear returns
+// them. ByMonth is indexed from January and ByWeekday from Sunday, so the JSON form is
+// the same shape for every country and year.
+type HolidayStats struct {
+	Year       int                     `json:"year"`
+	Total      int                     `json:"total"`
+	ByCategory map[HolidayCategory]int `json:"by_category"` // As loaded, e.g. "federal" is not merged into "public"
+	ByMonth    [12]int                 `json:"by_month"`
+	ByWeekday  [7]int                  `json:"by_weekday"`
+	OnWeekends int                     `json:"on_weekends"` // On a Saturday or Sunday
+
+	// Fixed holidays fall on the same day of the same month in the neighbouring years,
+	// and Easter-based ones the same number of days from (Western) Easter Sunday. Others,
+	// such as nth-weekday, lunar and one-off holidays, count as Other.
+	Fixed       int `json:"fixed"`
+	EasterBased int `json:"easter_based"`
+	Other       int `json:"other"`
+}
+
+// Statistics returns counts of the holidays of a year by category, month and weekday,
+// along with how many fall on weekends and how many are fixed or Easter-based. Telling
+// fixed and Easter-based holidays apart loads the previous and next years.
+func (c *Country) Statistics(year int) HolidayStats {
+	stats := HolidayStats{
+		Year:       year,
+		ByCategory: make(map[HolidayCategory]int),
+	}
+
+	for date, holiday := range c.HolidaysForYear(year) {
+		stats.Total++
+		stats.ByCategory[holiday.Category]++
+		stats.ByMonth[date.Month()-1]++
+		stats.ByWeekday[date.Weekday()]++
+		if weekday := date.Weekday(); weekday == time.Saturday || weekday == time.Sunday {
+			stats.OnWeekends++
+		}
+
+		switch c.recurrenceKind(holiday) {
+		case "fixed":
+			stats.Fixed++
+		case "easter":
+			stats.EasterBased++
+		default:
+			stats.Other++
+		}
+	}
+
+	return stats
+}
+
+// recurrenceKind classifies a holiday as "fixed", "easter" or "" by comparing its date
+// with the holidays of the same name in the neighbouring years
+func (c *Country) recurrenceKind(holiday *Holiday) string {
+	year := holiday.Date.Year()
+	easterOffset := daysBetween(c.easterSunday(year), holiday.Date)
+
+	fixed, easter, found := true, true, false
+	for _, neighbour := range []int{year - 1, year + 1} {
+		for _, other := range c.holidaysNamed(neighbour, holiday.Name) {
+			found = true
+			fixed = fixed && other.Date.Month() == holiday.Date.Month() && other.Date.Day() == holiday.Date.Day()
+			easter = easter && daysBetween(c.easterSunday(neighbour), other.Date) == easterOffset
+		}
+	}
+
+	switch {
+	case !found:
+		return ""
+	case fixed:
+		return "fixed"
+	case easter:
+		return "easter"
+	default:
+		return ""
+	}
+}
+
+// daysBetween returns the number of days from start to end
+func daysBetween(start, end time.Time) int {
+	return int(end.Sub(start).Hours() / 24)
+}
+
+// HolidayPair is a date on which both compared countries have a holiday
+type HolidayPair struct {
+	Date time.Time
+	A, B *Holiday
+}
+
+// CountryComparison is the result of CompareCountries. Each list is sorted by date.
+type CountryComparison struct {
+	Year  int
+	OnlyA []*Holiday // Dates that are holidays in A only
+	OnlyB []*Holiday // Dates that are holidays in B only
+	// Shared holds dates on which both countries have the same holiday
+	Shared []HolidayPair
+	// SameDateDifferentName holds dates that are holidays in both countries under
+	// different names, such as Columbus Day in the US and Thanksgiving Day in Canada
+	SameDateDifferentName []HolidayPair
+}
+
+// CompareCountries compares the holidays of two countries in a year by date. Holidays
+// on the same date are the same holiday when their English names match, so "Año Nuevo"
+// in Mexico and "New Year's Day" in the US count as shared.
+func CompareCountries(a, b *Country, year int) CountryComparison {
+	comparison := CountryComparison{Year: year}
+	holidaysB := b.HolidaysForYear(year)
+
+	for _, holiday := range a.SortedHolidaysForYear(year) {
+		other, shared := holidaysB[holiday.Date]
+		switch {
+		case !shared:
+			comparison.OnlyA = append(comparison.OnlyA, holiday)
+		case englishName(holiday) == englishName(other):
+			comparison.Shared = append(comparison.Shared, HolidayPair{Date: holiday.Date, A: holiday, B: other})
+		default:
+			comparison.SameDateDifferentName = append(comparison.SameDateDifferentName,
+				HolidayPair{Date: holiday.Date, A: holiday, B: other})
+		}
+	}
+
+	holidaysA := a.HolidaysForYear(year)
+	for _, holiday := range b.SortedHolidaysForYear(year) {
+		if _, shared := holidaysA[holiday.Date]; !shared {
+			comparison.OnlyB = append(comparison.OnlyB, holiday)
+		}
+	}
+
+	return comparison
+}
+
+// englishName returns the holiday's English name, or its name if it has none
+func englishName(holiday *Holiday) string {
+	return holiday.LocalName("en")
+}
@@ -0,0 +1,220 @@
+package goholidays
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWeekendHolidayCount(t *testing.T) {
+	us := NewCountry("US")
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	// 2020: Independence Day; 2021: Juneteenth, Independence Day, Christmas;
+	// 2022: New Year's Day, Juneteenth, Christmas; 2023: New Year's Day, Veterans Day
+	count := us.WeekendHolidayCount(start, end)
+	expected := 9
+
+	if count != expected {
+		t.Errorf("Expected %d weekend holidays, got %d", expected, count)
+	}
+}
+
+func TestMonthlyHolidayCounts(t *testing.T) {
+	us := NewCountry("US")
+	counts := us.MonthlyHolidayCounts(2024)
+
+	if len(counts) != 12 {
+		t.Errorf("Expected counts for 12 months, got %d", len(counts))
+	}
+
+	total := 0
+	for _, count := range counts {
+		total += count
+	}
+	if total != len(us.HolidaysForYear(2024)) {
+		t.Errorf("Expected monthly counts to sum to %d, got %d", len(us.HolidaysForYear(2024)), total)
+	}
+
+	if counts[time.November] != 2 {
+		t.Errorf("Expected 2 holidays in November, got %d", counts[time.November])
+	}
+}
+
+func TestBusiestAndLeanestMonth(t *testing.T) {
+	it := NewCountry("IT")
+
+	// Immaculate Conception, Christmas and St. Stephen's Day
+	month, count := it.BusiestMonth(2024)
+	if month != time.December || count != 3 {
+		t.Errorf("Expected December with 3 holidays, got %s with %d", month, count)
+	}
+
+	month, count = it.LeanestMonth(2024)
+	if month != time.February || count != 0 {
+		t.Errorf("Expected February with 0 holidays, got %s with %d", month, count)
+	}
+}
+
+func TestPartitionByReligious(t *testing.T) {
+	in := NewCountry("IN")
+	religious, secular := in.PartitionByReligious(2024)
+
+	if len(religious)+len(secular) != len(in.HolidaysForYear(2024)) {
+		t.Error("Partition should cover every holiday exactly once")
+	}
+
+	goodFriday := time.Date(2024, 3, 29, 0, 0, 0, 0, time.UTC)
+	if _, exists := religious[goodFriday]; !exists {
+		t.Error("Good Friday should be in the religious partition")
+	}
+
+	republicDay := time.Date(2024, 1, 26, 0, 0, 0, 0, time.UTC)
+	if _, exists := secular[republicDay]; !exists {
+		t.Error("Republic Day should be in the secular partition")
+	}
+
+	// Orthodox holidays count as religious
+	ua := NewCountry("UA")
+	religious, _ = ua.PartitionByReligious(2024)
+	for _, holiday := range religious {
+		if holiday.Category != CategoryReligious && holiday.Category != "orthodox" {
+			t.Errorf("Unexpected %s holiday %s in religious partition", holiday.Category, holiday.Name)
+		}
+	}
+	if len(religious) == 0 {
+		t.Error("Expected Orthodox holidays in the religious partition for Ukraine")
+	}
+}
+
+func TestHolidayWeekdayDistribution(t *testing.T) {
+	us := NewCountry("US")
+
+	// Christmas Day 2015-2024: Fri, Sun, Mon, Tue, Wed, Fri, Sat, Sun, Mon, Wed
+	distribution := us.HolidayWeekdayDistribution("Christmas Day", 2015, 2024)
+	expected := map[time.Weekday]int{
+		time.Sunday:    2,
+		time.Monday:    2,
+		time.Tuesday:   1,
+		time.Wednesday: 2,
+		time.Friday:    2,
+		time.Saturday:  1,
+	}
+
+	total := 0
+	for weekday, count := range distribution {
+		total += count
+		if count != expected[weekday] {
+			t.Errorf("%s: expected %d, got %d", weekday, expected[weekday], count)
+		}
+	}
+	if total != 10 {
+		t.Errorf("Expected 10 occurrences, got %d", total)
+	}
+
+	weekend := distribution[time.Saturday] + distribution[time.Sunday]
+	if weekend != 3 {
+		t.Errorf("Expected Christmas on a weekend 3 times, got %d", weekend)
+	}
+
+	if unknown := us.HolidayWeekdayDistribution("Not A Holiday", 2015, 2024); len(unknown) != 0 {
+		t.Errorf("Expected empty distribution for unknown holiday, got %v", unknown)
+	}
+}
+
+func TestCompareCountries(t *testing.T) {
+	comparison := CompareCountries(NewCountry("BR"), NewCountry("MX"), 2024)
+	if comparison.Year != 2024 {
+		t.Errorf("Expected year 2024, got %d", comparison.Year)
+	}
+
+	// Shared holidays match by English name, keeping each side's own holiday
+	var shared []string
+	for _, pair := range comparison.Shared {
+		shared = append(shared, pair.A.Name+"/"+pair.B.Name)
+		if !pair.A.Date.Equal(pair.Date) || !pair.B.Date.Equal(pair.Date) {
+			t.Errorf("Pair on %s holds holidays on %s and %s", pair.Date, pair.A.Date, pair.B.Date)
+		}
+	}
+	if len(shared) == 0 || shared[0] != "Confraternização Universal/Año Nuevo" {
+		t.Errorf("Expected New Year's Day to be shared first, got %v", shared)
+	}
+
+	// All Souls' Day and the Day of the Dead fall on the same date under different names
+	found := false
+	for _, pair := range comparison.SameDateDifferentName {
+		if pair.Date.Equal(time.Date(2024, 11, 2, 0, 0, 0, 0, time.UTC)) {
+			found = pair.A.Name == "Finados" && pair.B.Name == "Día de los Muertos"
+		}
+	}
+	if !found {
+		t.Errorf("Expected Finados and Día de los Muertos on Nov 2, got %v", comparison.SameDateDifferentName)
+	}
+
+	// Every holiday of each side lands in exactly one bucket
+	total := len(comparison.Shared) + len(comparison.SameDateDifferentName)
+	if count := len(NewCountry("BR").HolidaysForYear(2024)); len(comparison.OnlyA)+total != count {
+		t.Errorf("Expected %d Brazilian holidays across buckets, got %d", count, len(comparison.OnlyA)+total)
+	}
+	if count := len(NewCountry("MX").HolidaysForYear(2024)); len(comparison.OnlyB)+total != count {
+		t.Errorf("Expected %d Mexican holidays across buckets, got %d", count, len(comparison.OnlyB)+total)
+	}
+	for i := 1; i < len(comparison.OnlyA); i++ {
+		if comparison.OnlyA[i].Date.Before(comparison.OnlyA[i-1].Date) {
+			t.Error("Expected holidays unique to A sorted by date")
+		}
+	}
+	for _, holiday := range comparison.OnlyB {
+		if holiday.Name == "Día de la Independencia" {
+			return
+		}
+	}
+	t.Error("Expected Mexican Independence Day to be unique to Mexico")
+}
+
+func TestStatistics(t *testing.T) {
+	us := NewCountry("US")
+	stats := us.Statistics(2024)
+
+	if stats.Year != 2024 || stats.Total != len(us.HolidaysForYear(2024)) {
+		t.Errorf("Expected all %d holidays of 2024, got %+v", len(us.HolidaysForYear(2024)), stats)
+	}
+	if stats.ByCategory[CategoryFederal] != stats.Total {
+		t.Errorf("Expected every US holiday to be federal, got %v", stats.ByCategory)
+	}
+	// New Year's Day, Juneteenth, Independence Day, Veterans Day and Christmas Day
+	if stats.Fixed != 5 || stats.EasterBased != 0 || stats.Other != 6 {
+		t.Errorf("Expected 5 fixed and 6 other holidays, got %d fixed, %d Easter-based, %d other",
+			stats.Fixed, stats.EasterBased, stats.Other)
+	}
+	if stats.ByMonth[time.January-1] != 2 || stats.ByMonth[time.November-1] != 2 || stats.ByMonth[time.March-1] != 0 {
+		t.Errorf("Unexpected monthly counts %v", stats.ByMonth)
+	}
+	if stats.ByWeekday[time.Monday] != 7 || stats.OnWeekends != 0 {
+		t.Errorf("Expected 7 Monday holidays and none on weekends, got %v and %d", stats.ByWeekday, stats.OnWeekends)
+	}
+
+	// Good Friday through Corpus Christi follow Easter
+	de := NewCountry("DE").Statistics(2024)
+	if de.EasterBased != 6 {
+		t.Errorf("Expected 6 Easter-based German holidays, got %d", de.EasterBased)
+	}
+	// Christmas Day 2022 fell on a Sunday
+	if gb := NewCountry("GB").Statistics(2022); gb.OnWeekends != gb.ByWeekday[time.Saturday]+gb.ByWeekday[time.Sunday] || gb.OnWeekends == 0 {
+		t.Errorf("Expected weekend holidays in GB 2022, got %d", gb.OnWeekends)
+	}
+
+	for _, s := range []HolidayStats{stats, de} {
+		months, weekdays := 0, 0
+		for _, n := range s.ByMonth {
+			months += n
+		}
+		for _, n := range s.ByWeekday {
+			weekdays += n
+		}
+		if months != s.Total || weekdays != s.Total || s.Fixed+s.EasterBased+s.Other != s.Total {
+			t.Errorf("Expected every breakdown to sum to %d, got %+v", s.Total, s)
+		}
+	}
+}
@@ -2,16 +2,33 @@ package goholidays
 
 import (
 	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
 	"time"
 )
 
 // BusinessDayCalculator provides business day calculations with holiday awareness
 type BusinessDayCalculator struct {
-	country  *Country
-	weekends []time.Weekday
+	country       *Country
+	weekends      []time.Weekday
+	extraClosures map[time.Time]bool
+	workdays      map[time.Time]bool // Weekend dates worked, set with SetWorkdays
+	market        *MarketOverlay
 }
 
-// NewBusinessDayCalculator creates a new business day calculator
+// MarketOverlay lists a market's own closures and shortened sessions, which differ from
+// the country's holidays: e.g. an exchange closed for a national day of mourning, or
+// closing early on Christmas Eve. Dates are compared by calendar day.
+type MarketOverlay struct {
+	Closures []time.Time // Days the market is closed although they are business days
+	HalfDays []time.Time // Days the market trades a shortened session
+}
+
+// NewBusinessDayCalculator creates a new business day calculator. Holidays are those the
+// country reports, so a country created with CountryOptions.Subdivisions also closes on
+// the holidays of those subdivisions, e.g. Patriots' Day for "MA".
 func NewBusinessDayCalculator(country *Country) *BusinessDayCalculator {
 	return &BusinessDayCalculator{
 		country:  country,
@@ -24,18 +41,169 @@ func (bdc *BusinessDayCalculator) SetWeekends(weekends []time.Weekday) {
 	bdc.weekends = weekends
 }
 
-// IsBusinessDay checks if a date is a business day (not weekend or holiday)
+// SetWorkingWeekdays sets the days of the week that are worked, e.g. Monday to Thursday
+// for a four-day week. It is the inverse of SetWeekends: every other weekday becomes a weekend day.
+func (bdc *BusinessDayCalculator) SetWorkingWeekdays(days []time.Weekday) {
+	working := make(map[time.Weekday]bool, len(days))
+	for _, day := range days {
+		working[day] = true
+	}
+
+	weekends := []time.Weekday{}
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if !working[day] {
+			weekends = append(weekends, day)
+		}
+	}
+	bdc.weekends = weekends
+}
+
+// WorkingWeekdays returns the days of the week that are worked, derived from the weekend days
+func (bdc *BusinessDayCalculator) WorkingWeekdays() []time.Weekday {
+	weekend := make(map[time.Weekday]bool, len(bdc.weekends))
+	for _, day := range bdc.weekends {
+		weekend[day] = true
+	}
+
+	var days []time.Weekday
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if !weekend[day] {
+			days = append(days, day)
+		}
+	}
+	return days
+}
+
+// AddExtraNonBusinessDays marks additional closure dates, such as company-wide days off,
+// as non-business days on top of the country's holidays
+func (bdc *BusinessDayCalculator) AddExtraNonBusinessDays(dates ...time.Time) {
+	if bdc.extraClosures == nil {
+		bdc.extraClosures = make(map[time.Time]bool)
+	}
+	for _, date := range dates {
+		bdc.extraClosures[time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)] = true
+	}
+}
+
+// SetWorkdays marks dates as working days even though they fall on a weekend, such as
+// the Saturdays worked in exchange for a bridge holiday in China or South Korea. It
+// replaces any dates set before. Holidays on these dates still close them.
+func (bdc *BusinessDayCalculator) SetWorkdays(dates ...time.Time) {
+	bdc.workdays = make(map[time.Time]bool, len(dates))
+	for _, date := range dates {
+		bdc.workdays[time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)] = true
+	}
+}
+
+// SetMarketOverlay sets the market closures and half-days used by the trading day
+// methods. Business day methods are unaffected.
+func (bdc *BusinessDayCalculator) SetMarketOverlay(overlay MarketOverlay) {
+	bdc.market = &overlay
+}
+
+// IsTradingDay checks if the market trades on date: it must be a business day and not
+// a closure in the market overlay. Half-days are trading days.
+func (bdc *BusinessDayCalculator) IsTradingDay(date time.Time) bool {
+	if !bdc.IsBusinessDay(date) {
+		return false
+	}
+	return bdc.market == nil || !containsDay(bdc.market.Closures, date)
+}
+
+// IsHalfDay checks if date is a trading day with a shortened session
+func (bdc *BusinessDayCalculator) IsHalfDay(date time.Time) bool {
+	return bdc.market != nil && containsDay(bdc.market.HalfDays, date) && bdc.IsTradingDay(date)
+}
+
+// TradingDaysBetween counts the trading days from start up to, but not including, end,
+// counting half-days as whole days
+func (bdc *BusinessDayCalculator) TradingDaysBetween(start, end time.Time) int {
+	if start.After(end) {
+		return -bdc.TradingDaysBetween(end, start)
+	}
+
+	count := 0
+	for current := start; current.Before(end); current = current.AddDate(0, 0, 1) {
+		if bdc.IsTradingDay(current) {
+			count++
+		}
+	}
+	return count
+}
+
+// containsDay reports whether dates contains the calendar day of date
+func containsDay(dates []time.Time, date time.Time) bool {
+	for _, d := range dates {
+		if d.Year() == date.Year() && d.Month() == date.Month() && d.Day() == date.Day() {
+			return true
+		}
+	}
+	return false
+}
+
+// IsBusinessDay checks if a date is a business day (not weekend, holiday or extra closure)
 func (bdc *BusinessDayCalculator) IsBusinessDay(date time.Time) bool {
-	// Check if it's a weekend
-	for _, weekend := range bdc.weekends {
-		if date.Weekday() == weekend {
-			return false
+	reason, _ := bdc.closureReason(date)
+	return reason == ""
+}
+
+// closureReason returns why date is not a business day ("weekend", "closure" or
+// "holiday", with the holiday), or "" when it is a business day. A weekend date set
+// with SetWorkdays, or carrying a CategoryWorkday holiday, is worked; a CategoryHalfDay
+// holiday leaves the day open for part of it.
+func (bdc *BusinessDayCalculator) closureReason(date time.Time) (string, *Holiday) {
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	holiday, isHoliday := bdc.country.IsHoliday(date)
+	category := HolidayCategory("")
+	if isHoliday {
+		category = canonicalCategory(holiday.Category)
+	}
+
+	// Check if it's a weekend that is not worked
+	if !bdc.workdays[day] && category != CategoryWorkday {
+		for _, weekend := range bdc.weekends {
+			if date.Weekday() == weekend {
+				return "weekend", nil
+			}
 		}
 	}
 
+	// Check if it's an extra closure day
+	if bdc.extraClosures[day] {
+		return "closure", nil
+	}
+
 	// Check if it's a holiday
-	_, isHoliday := bdc.country.IsHoliday(date)
-	return !isHoliday
+	if isHoliday && category != CategoryWorkday && category != CategoryHalfDay {
+		return "holiday", holiday
+	}
+	return "", nil
+}
+
+// BusinessDayFraction returns how much of date is worked: 1 for a business day, 0.5 for
+// a business day that is a CategoryHalfDay holiday, and 0 otherwise
+func (bdc *BusinessDayCalculator) BusinessDayFraction(date time.Time) float64 {
+	if !bdc.IsBusinessDay(date) {
+		return 0
+	}
+	if holiday, isHoliday := bdc.country.IsHoliday(date); isHoliday && canonicalCategory(holiday.Category) == CategoryHalfDay {
+		return 0.5
+	}
+	return 1
+}
+
+// FractionalBusinessDaysBetween counts the business days from start up to, but not
+// including, end, like BusinessDaysBetween but counting half-days as 0.5
+func (bdc *BusinessDayCalculator) FractionalBusinessDaysBetween(start, end time.Time) float64 {
+	if start.After(end) {
+		return -bdc.FractionalBusinessDaysBetween(end, start)
+	}
+
+	total := 0.0
+	for current := start; current.Before(end); current = current.AddDate(0, 0, 1) {
+		total += bdc.BusinessDayFraction(current)
+	}
+	return total
 }
 
 // NextBusinessDay returns the next business day after the given date
@@ -56,10 +224,75 @@ func (bdc *BusinessDayCalculator) PreviousBusinessDay(date time.Time) time.Time
 	return prev
 }
 
-// AddBusinessDays adds a specified number of business days to a date
+// RollConvention selects how a date falling on a non-business day is rolled to a business
+// day, following the ISDA business day conventions
+type RollConvention int
+
+const (
+	// RollFollowing moves the date to the next business day
+	RollFollowing RollConvention = iota
+	// RollModifiedFollowing moves the date to the next business day, unless that falls in
+	// the next month, in which case it moves to the previous business day
+	RollModifiedFollowing
+	// RollPreceding moves the date to the previous business day
+	RollPreceding
+	// RollModifiedPreceding moves the date to the previous business day, unless that falls
+	// in the previous month, in which case it moves to the next business day
+	RollModifiedPreceding
+	// RollUnadjusted leaves the date as it is, even on a non-business day
+	RollUnadjusted
+)
+
+// Roll returns date if it is a business day, or else the business day the convention
+// moves it to. Interest and coupon schedules roll each unadjusted date this way.
+func (bdc *BusinessDayCalculator) Roll(date time.Time, convention RollConvention) time.Time {
+	if convention == RollUnadjusted || bdc.IsBusinessDay(date) {
+		return date
+	}
+
+	switch convention {
+	case RollModifiedFollowing:
+		if next := bdc.NextBusinessDay(date); next.Month() == date.Month() {
+			return next
+		}
+		return bdc.PreviousBusinessDay(date)
+	case RollPreceding:
+		return bdc.PreviousBusinessDay(date)
+	case RollModifiedPreceding:
+		if prev := bdc.PreviousBusinessDay(date); prev.Month() == date.Month() {
+			return prev
+		}
+		return bdc.NextBusinessDay(date)
+	default:
+		return bdc.NextBusinessDay(date)
+	}
+}
+
+// PayConvention selects how a pay date falling on a non-business day is moved
+type PayConvention = RollConvention
+
+// Pay date conventions, see the RollConvention constants of the same name. Payroll commonly
+// pays on PayModifiedPreceding, so that the pay stays within the month it is due.
+const (
+	PayFollowing         = RollFollowing
+	PayModifiedFollowing = RollModifiedFollowing
+	PayPreceding         = RollPreceding
+	PayModifiedPreceding = RollModifiedPreceding
+)
+
+// AdjustPayDate returns target if it is a business day, or else the business day the
+// convention moves it to
+func (bdc *BusinessDayCalculator) AdjustPayDate(target time.Time, convention PayConvention) time.Time {
+	return bdc.Roll(target, convention)
+}
+
+// AddBusinessDays moves date by the given number of business days: forward for a positive
+// count and backward for a negative one, so AddBusinessDays(d, -3) is PreviousBusinessDay
+// applied three times. A count of 0 returns date if it is a business day, or else the next
+// business day, like RollFollowing.
 func (bdc *BusinessDayCalculator) AddBusinessDays(date time.Time, days int) time.Time {
 	if days == 0 {
-		return date
+		return bdc.Roll(date, RollFollowing)
 	}
 
 	current := date
@@ -95,6 +328,46 @@ func (bdc *BusinessDayCalculator) BusinessDaysBetween(start, end time.Time) int
 	return count
 }
 
+// ObservedImpact counts the business days in year twice: once closing each holiday on
+// its observed date, and once closing holidays only on their actual dates. The
+// difference shows how many extra days off the observed rules grant, such as a
+// Sunday holiday observed on the following Monday. Substitute days, such as the UK's,
+// only close under the observed rules.
+func (bdc *BusinessDayCalculator) ObservedImpact(year int) (observedCount, actualCount int) {
+	actualClosed := make(map[time.Time]bool)
+	observedClosed := make(map[time.Time]bool)
+
+	// Holidays near the year boundary can be observed in the neighbouring year
+	for y := year - 1; y <= year+1; y++ {
+		for date, holiday := range bdc.country.HolidaysForYear(y) {
+			if y == year && holiday.SubstituteFor == "" {
+				actualClosed[date] = true
+			}
+			observed := date
+			if holiday.IsObserved && holiday.Observed != nil {
+				observed = time.Date(holiday.Observed.Year(), holiday.Observed.Month(), holiday.Observed.Day(), 0, 0, 0, 0, time.UTC)
+			}
+			observedClosed[observed] = true
+		}
+	}
+
+	start := time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(year+1, 1, 1, 0, 0, 0, 0, time.UTC)
+	for date := start; date.Before(end); date = date.AddDate(0, 0, 1) {
+		if reason, _ := bdc.closureReason(date); reason == "weekend" || reason == "closure" {
+			continue
+		}
+		if !actualClosed[date] {
+			actualCount++
+		}
+		if !observedClosed[date] {
+			observedCount++
+		}
+	}
+
+	return observedCount, actualCount
+}
+
 // IsEndOfMonth checks if a date is the last business day of the month
 func (bdc *BusinessDayCalculator) IsEndOfMonth(date time.Time) bool {
 	if !bdc.IsBusinessDay(date) {
@@ -169,6 +442,41 @@ func (has *HolidayAwareScheduler) ScheduleMonthlyEndOfMonth(start time.Time, mon
 	return schedule
 }
 
+// ScheduleMonthlyNthBusinessDay schedules events on the nth business day of each month,
+// counting from the first of the month, e.g. payroll run on the 5th business day. The
+// schedule begins with the month of start. When a month has fewer than n business days,
+// its event falls on the last business day of the month instead. An n below 1 is
+// treated as 1.
+func (has *HolidayAwareScheduler) ScheduleMonthlyNthBusinessDay(start time.Time, n int, months int) []time.Time {
+	if n < 1 {
+		n = 1
+	}
+
+	var schedule []time.Time
+	current := time.Date(start.Year(), start.Month(), 1, 0, 0, 0, 0, start.Location())
+
+	for i := 0; i < months; i++ {
+		nextMonth := current.AddDate(0, 1, 0)
+
+		// Count business days until the nth, remembering the latest in case the month runs out
+		var scheduled time.Time
+		count := 0
+		for day := current; day.Before(nextMonth) && count < n; day = day.AddDate(0, 0, 1) {
+			if has.calculator.IsBusinessDay(day) {
+				scheduled = day
+				count++
+			}
+		}
+		if !scheduled.IsZero() {
+			schedule = append(schedule, scheduled)
+		}
+
+		current = nextMonth
+	}
+
+	return schedule
+}
+
 // HolidayCalendar provides a calendar view with holiday information
 type HolidayCalendar struct {
 	country *Country
@@ -223,10 +531,21 @@ func (hc *HolidayCalendar) GenerateMonth(year int, month time.Month) []CalendarE
 
 // PrintMonth prints a formatted calendar for a month
 func (hc *HolidayCalendar) PrintMonth(year int, month time.Month) {
+	hc.RenderMonth(os.Stdout, year, month)
+}
+
+// RenderMonth writes a formatted calendar for a month to w. Month and weekday
+// names follow the country's language when a translation is available.
+func (hc *HolidayCalendar) RenderMonth(w io.Writer, year int, month time.Month) {
 	entries := hc.GenerateMonth(year, month)
+	language := hc.country.GetLanguage()
 
-	fmt.Printf("\n%s %d\n", month.String(), year)
-	fmt.Println("Su Mo Tu We Th Fr Sa")
+	fmt.Fprintf(w, "\n%s %d\n", MonthName(month, language), year)
+	header := make([]string, 7)
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		header[day] = PadToWidth(WeekdayAbbrev(day, language), 2) // Two columns, like the days
+	}
+	fmt.Fprintln(w, strings.Join(header, " "))
 
 	// Get first day of month to calculate starting position
 	firstDay := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
@@ -234,7 +553,7 @@ func (hc *HolidayCalendar) PrintMonth(year int, month time.Month) {
 
 	// Print leading spaces
 	for i := 0; i < startPos; i++ {
-		fmt.Print("   ")
+		fmt.Fprint(w, "   ")
 	}
 
 	// Print days
@@ -242,19 +561,149 @@ func (hc *HolidayCalendar) PrintMonth(year int, month time.Month) {
 		dayStr := fmt.Sprintf("%2d", entry.Date.Day())
 
 		if entry.IsHoliday {
-			fmt.Printf("*%s", dayStr[1:]) // Mark holidays with *
+			fmt.Fprintf(w, "*%s", dayStr[1:]) // Mark holidays with *
 		} else {
-			fmt.Print(dayStr)
+			fmt.Fprint(w, dayStr)
 		}
 
 		// New line after Saturday
 		if entry.Date.Weekday() == time.Saturday {
-			fmt.Println()
+			fmt.Fprintln(w)
 		} else {
-			fmt.Print(" ")
+			fmt.Fprint(w, " ")
+		}
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "* = Holiday")
+}
+
+// BusinessDaysByCategory counts the weekdays in a year that are not closed by a
+// holiday in one of closedCategories. Holidays in other categories are treated
+// as regular business days. Categories are compared by the standard category they
+// belong to, so CategoryPublic and CategoryFederal close the same holidays.
+func (hc *HolidayCalendar) BusinessDaysByCategory(year int, closedCategories []HolidayCategory) int {
+	closed := make(map[HolidayCategory]bool, len(closedCategories))
+	for _, category := range closedCategories {
+		closed[canonicalCategory(category)] = true
+	}
+
+	holidays := hc.country.HolidaysForYear(year)
+
+	count := 0
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(year+1, time.January, 1, 0, 0, 0, 0, time.UTC)
+	for current := start; current.Before(end); current = current.AddDate(0, 0, 1) {
+		if current.Weekday() == time.Saturday || current.Weekday() == time.Sunday {
+			continue
+		}
+		if holiday, found := holidays[current]; found && closed[canonicalCategory(holiday.Category)] {
+			continue
+		}
+		count++
+	}
+
+	return count
+}
+
+// BridgeSuggestion describes a single day of leave and the break it produces
+type BridgeSuggestion struct {
+	Date     time.Time  `json:"date"`     // The business day to take off
+	Start    time.Time  `json:"start"`    // First day of the resulting break
+	End      time.Time  `json:"end"`      // Last day of the resulting break
+	DaysOff  int        `json:"days_off"` // Consecutive days off, including weekends and holidays
+	Holidays []*Holiday `json:"holidays"` // Holidays within the break
+}
+
+// SuggestBridgeDay ranks single days of leave in a year by the length of the break they
+// produce when combined with adjacent weekends and holidays. Only days that join up with
+// at least one holiday are suggested. Results are ordered by DaysOff, longest first.
+func (c *Country) SuggestBridgeDay(year int) []BridgeSuggestion {
+	calc := NewBusinessDayCalculator(c)
+
+	var suggestions []BridgeSuggestion
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(year+1, time.January, 1, 0, 0, 0, 0, time.UTC)
+	for date := start; date.Before(end); date = date.AddDate(0, 0, 1) {
+		if !calc.IsBusinessDay(date) {
+			continue
+		}
+
+		first := date
+		for !calc.IsBusinessDay(first.AddDate(0, 0, -1)) {
+			first = first.AddDate(0, 0, -1)
+		}
+		last := date
+		for !calc.IsBusinessDay(last.AddDate(0, 0, 1)) {
+			last = last.AddDate(0, 0, 1)
+		}
+
+		var holidays []*Holiday
+		for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+			if holiday, isHoliday := c.IsHoliday(day); isHoliday {
+				holidays = append(holidays, holiday)
+			}
+		}
+		if len(holidays) == 0 {
+			continue
+		}
+
+		suggestions = append(suggestions, BridgeSuggestion{
+			Date:     date,
+			Start:    first,
+			End:      last,
+			DaysOff:  int(last.Sub(first).Hours()/24) + 1,
+			Holidays: holidays,
+		})
+	}
+
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		return suggestions[i].DaysOff > suggestions[j].DaysOff
+	})
+
+	return suggestions
+}
+
+// BridgeDays returns the working days of a year that sit in a short gap between a
+// holiday and a weekend, or between two holidays, such as the Monday before a Tuesday
+// holiday or the Friday after a Thursday one: the puentes of Mexico and Spain. A gap
+// may hold up to maxGap consecutive working days, so with 2 a Wednesday holiday also
+// bridges Monday and Tuesday. A maxGap below 1 is treated as 1. Unlike observed dates,
+// bridge days stay working days; this only points them out.
+func (c *Country) BridgeDays(year int, maxGap int) []time.Time {
+	if maxGap < 1 {
+		maxGap = 1
+	}
+	calc := NewBusinessDayCalculator(c)
+
+	var bridges []time.Time
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(year+1, time.January, 1, 0, 0, 0, 0, time.UTC)
+	for date := start; date.Before(end); date = date.AddDate(0, 0, 1) {
+		if !calc.IsBusinessDay(date) {
+			continue
+		}
+
+		// Find the run of working days around date, giving up once it exceeds maxGap
+		first, last, length := date, date, 1
+		for length <= maxGap && calc.IsBusinessDay(first.AddDate(0, 0, -1)) {
+			first = first.AddDate(0, 0, -1)
+			length++
+		}
+		for length <= maxGap && calc.IsBusinessDay(last.AddDate(0, 0, 1)) {
+			last = last.AddDate(0, 0, 1)
+			length++
+		}
+		if length > maxGap {
+			continue
+		}
+
+		before, _ := calc.closureReason(first.AddDate(0, 0, -1))
+		after, _ := calc.closureReason(last.AddDate(0, 0, 1))
+		if before == "holiday" || after == "holiday" {
+			bridges = append(bridges, date)
 		}
 	}
 
-	fmt.Println()
-	fmt.Println("* = Holiday")
+	return bridges
 }
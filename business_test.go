@@ -56,6 +56,46 @@ func TestAddBusinessDays(t *testing.T) {
 	}
 }
 
+func TestAddBusinessDaysAcrossHoliday(t *testing.T) {
+	calc := NewBusinessDayCalculator(NewCountry("US"))
+	day := func(d int) time.Time {
+		return time.Date(2024, time.July, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	// Independence Day, Thursday July 4, is skipped in both directions
+	tests := []struct {
+		name     string
+		start    time.Time
+		days     int
+		expected time.Time
+	}{
+		{"Forward over holiday", day(3), 1, day(5)},
+		{"Forward over holiday and weekend", day(3), 3, day(9)},
+		{"Backward over holiday", day(5), -1, day(3)},
+		{"Backward over weekend and holiday", day(8), -3, day(2)},
+		{"Zero on business day", day(3), 0, day(3)},
+		{"Zero on holiday", day(4), 0, day(5)},
+		{"Zero on weekend", day(6), 0, day(8)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := calc.AddBusinessDays(tt.start, tt.days); !got.Equal(tt.expected) {
+				t.Errorf("AddBusinessDays(%s, %d) = %s, expected %s", tt.start.Format("2006-01-02"), tt.days,
+					got.Format("2006-01-02"), tt.expected.Format("2006-01-02"))
+			}
+		})
+	}
+
+	// A negative count matches stepping back with PreviousBusinessDay
+	stepped := day(9)
+	for i := 0; i < 3; i++ {
+		stepped = calc.PreviousBusinessDay(stepped)
+	}
+	if got := calc.AddBusinessDays(day(9), -3); !got.Equal(stepped) {
+		t.Errorf("AddBusinessDays(-3) = %s, PreviousBusinessDay three times = %s", got.Format("2006-01-02"), stepped.Format("2006-01-02"))
+	}
+}
+
 func TestBusinessDaysBetween(t *testing.T) {
 	us := NewCountry("US")
 	calc := NewBusinessDayCalculator(us)
@@ -96,6 +136,45 @@ func TestHolidayAwareScheduler(t *testing.T) {
 	}
 }
 
+func TestScheduleMonthlyNthBusinessDay(t *testing.T) {
+	scheduler := NewHolidayAwareScheduler(NewCountry("US"))
+	day := func(month time.Month, d int) time.Time {
+		return time.Date(2024, month, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name     string
+		start    time.Time
+		n        int
+		months   int
+		expected []time.Time
+	}{
+		// New Year's Day and Labor Day push the count past the first of the month
+		{"Fifth business day", day(time.January, 15), 5, 3,
+			[]time.Time{day(time.January, 8), day(time.February, 7), day(time.March, 7)}},
+		{"After Labor Day", day(time.September, 1), 5, 1, []time.Time{day(time.September, 9)}},
+		{"First business day", day(time.July, 1), 1, 1, []time.Time{day(time.July, 1)}},
+		{"Below one", day(time.January, 1), 0, 1, []time.Time{day(time.January, 2)}},
+		// February 2024 has 20 business days and July 22, so both clamp to the last one
+		{"Clamped to month end", day(time.February, 1), 25, 1, []time.Time{day(time.February, 29)}},
+		{"Clamped in long month", day(time.July, 1), 25, 1, []time.Time{day(time.July, 31)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule := scheduler.ScheduleMonthlyNthBusinessDay(tt.start, tt.n, tt.months)
+			if len(schedule) != len(tt.expected) {
+				t.Fatalf("Expected %d scheduled events, got %d", len(tt.expected), len(schedule))
+			}
+			for i, date := range schedule {
+				if !date.Equal(tt.expected[i]) {
+					t.Errorf("Event %d: expected %s, got %s", i, tt.expected[i].Format("2006-01-02"), date.Format("2006-01-02"))
+				}
+			}
+		})
+	}
+}
+
 func TestHolidayCalendar(t *testing.T) {
 	us := NewCountry("US")
 	calendar := NewHolidayCalendar(us)
@@ -246,3 +325,483 @@ func BenchmarkIsBusinessDay(b *testing.B) {
 		calc.IsBusinessDay(date)
 	}
 }
+
+func TestBusinessDaysByCategory(t *testing.T) {
+	calendar := NewHolidayCalendar(NewCountry("IE"))
+
+	// 2024 has 262 weekdays; Ireland has 3 weekday public holidays and 3 bank holidays
+	tests := []struct {
+		name     string
+		closed   []HolidayCategory
+		expected int
+	}{
+		{"No Closures", nil, 262},
+		{"Public Only", []HolidayCategory{CategoryPublic}, 259},
+		{"Public And Bank", []HolidayCategory{CategoryPublic, CategoryBank}, 256},
+		{"Bank Only", []HolidayCategory{CategoryBank}, 259},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := calendar.BusinessDaysByCategory(2024, tt.closed); got != tt.expected {
+				t.Errorf("BusinessDaysByCategory(2024, %v) = %d, want %d", tt.closed, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestBusinessDaysByCategoryAliases(t *testing.T) {
+	calendar := NewHolidayCalendar(NewCountry("US"))
+
+	// US holidays are filed as federal, which belongs to the public category
+	public := calendar.BusinessDaysByCategory(2024, []HolidayCategory{CategoryPublic})
+	federal := calendar.BusinessDaysByCategory(2024, []HolidayCategory{CategoryFederal})
+	if public != federal {
+		t.Errorf("Expected public and federal closures to match, got %d and %d", public, federal)
+	}
+	if open := calendar.BusinessDaysByCategory(2024, nil); public >= open {
+		t.Errorf("Expected closing public holidays to remove business days, got %d of %d", public, open)
+	}
+}
+
+func TestSuggestBridgeDay(t *testing.T) {
+	us := NewCountry("US")
+	suggestions := us.SuggestBridgeDay(2024)
+
+	if len(suggestions) == 0 {
+		t.Fatal("Expected bridge day suggestions")
+	}
+
+	for i := 1; i < len(suggestions); i++ {
+		if suggestions[i].DaysOff > suggestions[i-1].DaysOff {
+			t.Errorf("Suggestions not ranked by days off at index %d", i)
+		}
+	}
+
+	// Thanksgiving 2024 is Thursday, November 28; taking Friday gives Thursday to Sunday off
+	friday := time.Date(2024, 11, 29, 0, 0, 0, 0, time.UTC)
+	var found *BridgeSuggestion
+	for i := range suggestions {
+		if suggestions[i].Date.Equal(friday) {
+			found = &suggestions[i]
+			break
+		}
+	}
+
+	if found == nil {
+		t.Fatal("Expected the Friday after Thanksgiving to be suggested")
+	}
+	if found.DaysOff != 4 {
+		t.Errorf("Expected a 4-day break, got %d", found.DaysOff)
+	}
+	if !found.Start.Equal(time.Date(2024, 11, 28, 0, 0, 0, 0, time.UTC)) || !found.End.Equal(time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected break from Nov 28 to Dec 1, got %s to %s", found.Start.Format("2006-01-02"), found.End.Format("2006-01-02"))
+	}
+	if len(found.Holidays) != 1 || found.Holidays[0].Name != "Thanksgiving Day" {
+		t.Errorf("Expected the break to include Thanksgiving Day, got %v", found.Holidays)
+	}
+
+	// A mid-week day far from any holiday is not suggested
+	for _, suggestion := range suggestions {
+		if suggestion.Date.Equal(time.Date(2024, 3, 13, 0, 0, 0, 0, time.UTC)) {
+			t.Error("Days that do not join a holiday should not be suggested")
+		}
+	}
+}
+
+func TestAddExtraNonBusinessDays(t *testing.T) {
+	us := NewCountry("US")
+	calc := NewBusinessDayCalculator(us)
+
+	start := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	// March 2024 has 21 weekdays and no US federal holidays
+	if count := calc.BusinessDaysBetween(start, end); count != 21 {
+		t.Fatalf("Expected 21 business days in March 2024, got %d", count)
+	}
+
+	offsite := time.Date(2024, 3, 14, 0, 0, 0, 0, time.UTC)
+	localEvent := time.Date(2024, 3, 22, 13, 0, 0, 0, time.UTC)
+	calc.AddExtraNonBusinessDays(offsite, localEvent)
+
+	if calc.IsBusinessDay(offsite) {
+		t.Error("Extra closure day should not be a business day")
+	}
+	if calc.IsBusinessDay(time.Date(2024, 3, 22, 0, 0, 0, 0, time.UTC)) {
+		t.Error("Extra closure day should apply to the whole calendar date")
+	}
+
+	if count := calc.BusinessDaysBetween(start, end); count != 19 {
+		t.Errorf("Expected 19 business days after adding closures, got %d", count)
+	}
+
+	if next := calc.NextBusinessDay(time.Date(2024, 3, 13, 0, 0, 0, 0, time.UTC)); !next.Equal(time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected next business day to skip the closure, got %v", next)
+	}
+
+	// Closures are independent of the country's holidays
+	if _, isHoliday := us.IsHoliday(offsite); isHoliday {
+		t.Error("Extra closure days should not become country holidays")
+	}
+}
+
+func TestSetWorkingWeekdays(t *testing.T) {
+	us := NewCountry("US")
+	calc := NewBusinessDayCalculator(us)
+	calc.SetWorkingWeekdays([]time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday})
+
+	working := calc.WorkingWeekdays()
+	if len(working) != 4 || working[0] != time.Monday || working[3] != time.Thursday {
+		t.Errorf("Expected Monday to Thursday, got %v", working)
+	}
+
+	if calc.IsBusinessDay(time.Date(2024, 11, 29, 0, 0, 0, 0, time.UTC)) {
+		t.Error("Friday should not be a business day in a four-day week")
+	}
+
+	// November 2024 has 16 Monday-Thursday days; Veterans Day (Mon) and Thanksgiving (Thu) fall on them
+	start := time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC)
+	if count := calc.BusinessDaysBetween(start, end); count != 14 {
+		t.Errorf("Expected 14 business days in November 2024, got %d", count)
+	}
+
+	// SetWeekends and SetWorkingWeekdays describe the same schedule
+	calc.SetWeekends([]time.Weekday{time.Friday, time.Saturday})
+	working = calc.WorkingWeekdays()
+	expected := []time.Weekday{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday}
+	if len(working) != len(expected) {
+		t.Fatalf("Expected %v, got %v", expected, working)
+	}
+	for i, day := range expected {
+		if working[i] != day {
+			t.Errorf("Expected %v, got %v", expected, working)
+			break
+		}
+	}
+}
+
+func TestObservedImpact(t *testing.T) {
+	calc := NewBusinessDayCalculator(NewCountry("US"))
+
+	// In 2021 Juneteenth (Saturday) was observed on Friday June 18, Independence Day
+	// (Sunday) on Monday July 5, Christmas (Saturday) on Friday December 24 and New
+	// Year's Day 2022 (Saturday) on Friday December 31, so observed rules close four
+	// weekdays that naive handling keeps open
+	observed, actual := calc.ObservedImpact(2021)
+	if actual-observed != 4 {
+		t.Fatalf("Expected observed rules to remove 4 business days, got observed=%d actual=%d", observed, actual)
+	}
+
+	for _, date := range []time.Time{
+		time.Date(2021, 7, 5, 0, 0, 0, 0, time.UTC),
+		time.Date(2021, 12, 24, 0, 0, 0, 0, time.UTC),
+		time.Date(2021, 12, 31, 0, 0, 0, 0, time.UTC),
+	} {
+		if !calc.IsBusinessDay(date) {
+			t.Errorf("Expected %s to be a business day under actual-date handling", date.Format("2006-01-02"))
+		}
+	}
+
+	// In 2024 every holiday fell on a weekday, so observed rules change nothing
+	observed, actual = calc.ObservedImpact(2024)
+	if observed != actual {
+		t.Errorf("Expected no observed impact in 2024, got observed=%d actual=%d", observed, actual)
+	}
+}
+
+func TestObservedImpactSubstituteDays(t *testing.T) {
+	calc := NewBusinessDayCalculator(NewCountry("GB"))
+
+	// In 2022 New Year's Day fell on a Saturday and Christmas Day on a Sunday, so the UK
+	// took substitute days on Monday January 3 and on Tuesday December 27 after Boxing Day
+	observed, actual := calc.ObservedImpact(2022)
+	if actual-observed != 2 {
+		t.Errorf("Expected the substitute days to remove 2 business days, got observed=%d actual=%d", observed, actual)
+	}
+}
+
+func TestSolidarityDayBusinessDays(t *testing.T) {
+	// Whit Monday 2024 fell on May 20
+	whitMonday := time.Date(2024, 5, 20, 0, 0, 0, 0, time.UTC)
+	weekEnd := time.Date(2024, 5, 25, 0, 0, 0, 0, time.UTC)
+
+	holiday := NewBusinessDayCalculator(NewCountry("FR"))
+	if holiday.IsBusinessDay(whitMonday) {
+		t.Error("Expected Whit Monday to be a holiday by default")
+	}
+	if got := holiday.BusinessDaysBetween(whitMonday, weekEnd); got != 4 {
+		t.Errorf("Expected 4 business days in the week of Whit Monday, got %d", got)
+	}
+
+	solidarity := NewBusinessDayCalculator(NewCountry("FR", CountryOptions{SolidarityDay: true}))
+	if !solidarity.IsBusinessDay(whitMonday) {
+		t.Error("Expected Whit Monday to be a business day as the journée de solidarité")
+	}
+	if got := solidarity.BusinessDaysBetween(whitMonday, weekEnd); got != 5 {
+		t.Errorf("Expected 5 business days in the week of Whit Monday, got %d", got)
+	}
+
+	// The day is still listed, as optional, when optional holidays are requested
+	optional := NewCountry("FR", CountryOptions{SolidarityDay: true, IncludeOptional: true})
+	if h, ok := optional.IsHoliday(whitMonday); !ok || h.Category != CategoryOptional {
+		t.Errorf("Expected Whit Monday as an optional holiday, got %v", h)
+	}
+
+	// Other countries are unaffected
+	de := NewBusinessDayCalculator(NewCountry("DE", CountryOptions{SolidarityDay: true}))
+	if de.IsBusinessDay(whitMonday) {
+		t.Error("Expected SolidarityDay to leave Germany's Whit Monday a holiday")
+	}
+}
+
+func TestSubdivisionBusinessDays(t *testing.T) {
+	// Patriots' Day 2024 fell on Monday, April 15
+	patriotsDay := time.Date(2024, 4, 15, 0, 0, 0, 0, time.UTC)
+	start := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	national := NewBusinessDayCalculator(NewCountry("US"))
+	if !national.IsBusinessDay(patriotsDay) {
+		t.Error("Expected Patriots' Day to be a business day nationally")
+	}
+	if got := national.BusinessDaysBetween(start, end); got != 22 {
+		t.Errorf("Expected 22 national business days in April 2024, got %d", got)
+	}
+
+	ma := NewBusinessDayCalculator(NewCountry("US", CountryOptions{Subdivisions: []string{"MA"}}))
+	if ma.IsBusinessDay(patriotsDay) {
+		t.Error("Expected Patriots' Day to be a holiday in Massachusetts")
+	}
+	if got := ma.BusinessDaysBetween(start, end); got != 21 {
+		t.Errorf("Expected 21 Massachusetts business days in April 2024, got %d", got)
+	}
+	if next := ma.NextBusinessDay(patriotsDay.AddDate(0, 0, -3)); !next.Equal(patriotsDay.AddDate(0, 0, 1)) {
+		t.Errorf("Expected the next Massachusetts business day after April 12 to be April 16, got %s", next.Format("2006-01-02"))
+	}
+}
+
+func TestHalfDaysAndWorkdays(t *testing.T) {
+	// Christmas Eve 2024, a Tuesday, closes at noon
+	us := NewCountry("US")
+	christmasEve := time.Date(2024, 12, 24, 0, 0, 0, 0, time.UTC)
+	us.AddCustomHoliday(christmasEve, &Holiday{Name: "Christmas Eve", Category: CategoryHalfDay})
+
+	calc := NewBusinessDayCalculator(us)
+	if !calc.IsBusinessDay(christmasEve) || calc.BusinessDayFraction(christmasEve) != 0.5 {
+		t.Errorf("Expected Christmas Eve to be half a business day, got %v", calc.BusinessDayFraction(christmasEve))
+	}
+
+	weekStart := time.Date(2024, 12, 23, 0, 0, 0, 0, time.UTC)
+	weekEnd := time.Date(2024, 12, 28, 0, 0, 0, 0, time.UTC)
+	if got := calc.FractionalBusinessDaysBetween(weekStart, weekEnd); got != 3.5 {
+		t.Errorf("Expected 3.5 business days in the week of Christmas 2024, got %v", got)
+	}
+	if got := calc.BusinessDaysBetween(weekStart, weekEnd); got != 4 {
+		t.Errorf("Expected 4 whole business days in the week of Christmas 2024, got %d", got)
+	}
+	if got := calc.FractionalBusinessDaysBetween(weekEnd, weekStart); got != -3.5 {
+		t.Errorf("Expected -3.5 business days with the dates reversed, got %v", got)
+	}
+
+	// A Saturday worked in exchange for a bridge holiday
+	kr := NewBusinessDayCalculator(NewCountry("KR"))
+	saturday := time.Date(2024, 10, 5, 0, 0, 0, 0, time.UTC)
+	if kr.IsBusinessDay(saturday) {
+		t.Fatal("Expected a Saturday not to be a business day by default")
+	}
+	kr.SetWorkdays(saturday)
+	if !kr.IsBusinessDay(saturday) || kr.BusinessDayFraction(saturday) != 1 {
+		t.Error("Expected the Saturday set as a workday to be a business day")
+	}
+	if next := kr.NextBusinessDay(saturday.AddDate(0, 0, -1)); !next.Equal(saturday) {
+		t.Errorf("Expected the next business day after Friday to be the worked Saturday, got %s", next.Format("2006-01-02"))
+	}
+	kr.SetWorkdays()
+	if kr.IsBusinessDay(saturday) {
+		t.Error("Expected SetWorkdays to replace the dates set before")
+	}
+
+	// Holidays filed as workdays open the weekend day too
+	kr.country.AddCustomHoliday(saturday, &Holiday{Name: "Substitute Workday", Category: CategoryWorkday})
+	if !kr.IsBusinessDay(saturday) {
+		t.Error("Expected a CategoryWorkday holiday to make its Saturday a business day")
+	}
+}
+
+func TestRoll(t *testing.T) {
+	calc := NewBusinessDayCalculator(NewCountry("US"))
+	day := func(year int, month time.Month, d int) time.Time {
+		return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name       string
+		date       time.Time
+		convention RollConvention
+		expected   time.Time
+	}{
+		// Saturday, August 31, 2024 is followed by Labor Day on Monday, September 2
+		{"Following into next month", day(2024, 8, 31), RollFollowing, day(2024, 9, 3)},
+		{"Modified following stays in month", day(2024, 8, 31), RollModifiedFollowing, day(2024, 8, 30)},
+		{"Preceding", day(2024, 8, 31), RollPreceding, day(2024, 8, 30)},
+		{"Modified preceding", day(2024, 8, 31), RollModifiedPreceding, day(2024, 8, 30)},
+		{"Unadjusted", day(2024, 8, 31), RollUnadjusted, day(2024, 8, 31)},
+		// Sunday, December 31, 2023 is followed by New Year's Day, so following crosses the year
+		{"Following into next year", day(2023, 12, 31), RollFollowing, day(2024, 1, 2)},
+		{"Modified following stays in year", day(2023, 12, 31), RollModifiedFollowing, day(2023, 12, 29)},
+		// Sunday, September 1, 2024 rolls forward past Labor Day
+		{"Modified following mid-month", day(2024, 9, 1), RollModifiedFollowing, day(2024, 9, 3)},
+		{"Modified preceding at month start", day(2024, 9, 1), RollModifiedPreceding, day(2024, 9, 3)},
+		{"Business day", day(2024, 9, 3), RollModifiedFollowing, day(2024, 9, 3)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := calc.Roll(tt.date, tt.convention); !got.Equal(tt.expected) {
+				t.Errorf("Roll(%s) = %s, expected %s",
+					tt.date.Format("2006-01-02"), got.Format("2006-01-02"), tt.expected.Format("2006-01-02"))
+			}
+		})
+	}
+}
+
+func TestAdjustPayDate(t *testing.T) {
+	calc := NewBusinessDayCalculator(NewCountry("US"))
+	day := func(year int, month time.Month, d int) time.Time {
+		return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name       string
+		target     time.Time
+		convention PayConvention
+		expected   time.Time
+	}{
+		// Memorial Day 2021 fell on Monday, May 31
+		{"Following at month end", day(2021, 5, 31), PayFollowing, day(2021, 6, 1)},
+		{"Modified following at month end", day(2021, 5, 31), PayModifiedFollowing, day(2021, 5, 28)},
+		{"Preceding at month end", day(2021, 5, 31), PayPreceding, day(2021, 5, 28)},
+		{"Modified preceding at month end", day(2021, 5, 31), PayModifiedPreceding, day(2021, 5, 28)},
+		// New Year's Day 2025 fell on a Wednesday
+		{"Following at month start", day(2025, 1, 1), PayFollowing, day(2025, 1, 2)},
+		{"Modified following at month start", day(2025, 1, 1), PayModifiedFollowing, day(2025, 1, 2)},
+		{"Preceding at month start", day(2025, 1, 1), PayPreceding, day(2024, 12, 31)},
+		{"Modified preceding at month start", day(2025, 1, 1), PayModifiedPreceding, day(2025, 1, 2)},
+		// Saturday, November 30, 2024 follows Thanksgiving and a regular Friday
+		{"Modified following on a weekend", day(2024, 11, 30), PayModifiedFollowing, day(2024, 11, 29)},
+		{"Business day", day(2024, 11, 29), PayModifiedPreceding, day(2024, 11, 29)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := calc.AdjustPayDate(tt.target, tt.convention); !got.Equal(tt.expected) {
+				t.Errorf("AdjustPayDate(%s) = %s, expected %s",
+					tt.target.Format("2006-01-02"), got.Format("2006-01-02"), tt.expected.Format("2006-01-02"))
+			}
+		})
+	}
+}
+
+func TestMarketOverlay(t *testing.T) {
+	calc := NewBusinessDayCalculator(NewCountry("US"))
+
+	// NYSE closed on January 9, 2025 for the national day of mourning for President
+	// Carter, a regular business day, and traded a half-day on Christmas Eve
+	mourning := time.Date(2025, 1, 9, 0, 0, 0, 0, time.UTC)
+	christmasEve := time.Date(2024, 12, 24, 0, 0, 0, 0, time.UTC)
+
+	if !calc.IsTradingDay(mourning) {
+		t.Error("Expected every business day to be a trading day without an overlay")
+	}
+
+	calc.SetMarketOverlay(MarketOverlay{
+		Closures: []time.Time{mourning},
+		HalfDays: []time.Time{christmasEve},
+	})
+
+	if !calc.IsBusinessDay(mourning) {
+		t.Error("Expected the overlay to leave business days unchanged")
+	}
+	if calc.IsTradingDay(mourning) {
+		t.Error("Expected the one-off market closure not to be a trading day")
+	}
+	if !calc.IsTradingDay(christmasEve) || !calc.IsHalfDay(christmasEve) {
+		t.Error("Expected Christmas Eve to be a half trading day")
+	}
+	if calc.IsHalfDay(mourning) {
+		t.Error("Expected a closure not to be a half-day")
+	}
+
+	// The week of January 6, 2025 has five business days but four trading days
+	weekStart := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+	weekEnd := time.Date(2025, 1, 11, 0, 0, 0, 0, time.UTC)
+	if got := calc.BusinessDaysBetween(weekStart, weekEnd); got != 5 {
+		t.Errorf("Expected 5 business days, got %d", got)
+	}
+	if got := calc.TradingDaysBetween(weekStart, weekEnd); got != 4 {
+		t.Errorf("Expected 4 trading days, got %d", got)
+	}
+	if got := calc.TradingDaysBetween(weekEnd, weekStart); got != -4 {
+		t.Errorf("Expected -4 trading days for a reversed range, got %d", got)
+	}
+
+	// Holidays and weekends are not trading days either
+	if calc.IsTradingDay(time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC)) {
+		t.Error("Expected Christmas Day not to be a trading day")
+	}
+}
+
+func TestBridgeDays(t *testing.T) {
+	day := func(month time.Month, d int) time.Time {
+		return time.Date(2024, month, d, 0, 0, 0, 0, time.UTC)
+	}
+	contains := func(dates []time.Time, date time.Time) bool {
+		for _, d := range dates {
+			if d.Equal(date) {
+				return true
+			}
+		}
+		return false
+	}
+
+	us := NewCountry("US")
+	bridges := us.BridgeDays(2024, 1)
+
+	// Thanksgiving is Thursday, November 28, bridging Friday to the weekend
+	if !contains(bridges, day(time.November, 29)) {
+		t.Error("Expected the Friday after Thanksgiving to be a bridge day")
+	}
+	// Christmas is Wednesday, so no single working day bridges it
+	if contains(bridges, day(time.December, 24)) || contains(bridges, day(time.December, 26)) {
+		t.Error("Expected no single-day bridge around a Wednesday Christmas")
+	}
+	// A Monday holiday is already next to the weekend
+	if contains(bridges, day(time.September, 3)) {
+		t.Error("Expected no bridge after Labor Day")
+	}
+	if !contains(us.BridgeDays(2024, 0), day(time.November, 29)) {
+		t.Error("Expected a maxGap below 1 to behave like 1")
+	}
+
+	// With a gap of two, the Wednesday Christmas bridges both sides
+	wide := us.BridgeDays(2024, 2)
+	for _, date := range []time.Time{day(time.December, 23), day(time.December, 24), day(time.December, 26), day(time.December, 27)} {
+		if !contains(wide, date) {
+			t.Errorf("Expected %s to be a bridge day with a gap of two", date.Format("2006-01-02"))
+		}
+	}
+
+	// Mexico's Día de la Independencia 2025 is Tuesday, September 16: Monday is a puente
+	mx := NewCountry("MX")
+	if !contains(mx.BridgeDays(2025, 1), time.Date(2025, 9, 15, 0, 0, 0, 0, time.UTC)) {
+		t.Error("Expected Monday, September 15, 2025 to be a puente in Mexico")
+	}
+
+	for i := 1; i < len(wide); i++ {
+		if !wide[i].After(wide[i-1]) {
+			t.Errorf("Expected bridge days in date order at index %d", i)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package goholidays
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// CacheStats reports how a Country's cache of loaded years has been used
+type CacheStats struct {
+	Years     int    // Years currently loaded
+	Hits      uint64 // Lookups answered from an already loaded year
+	Misses    uint64 // Lookups that had to load their year
+	Evictions uint64 // Years dropped to stay within MaxCachedYears
+}
+
+// yearCache tracks when each loaded year was last used, so the least recently used one
+// can be evicted. Timestamps are atomic so lookups can record use under the read lock;
+// the lastUsed map itself only changes under the write lock.
+type yearCache struct {
+	maxYears  int // Zero keeps every year loaded
+	clock     atomic.Uint64
+	lastUsed  map[int]*atomic.Uint64
+	hits      atomic.Uint64
+	misses    atomic.Uint64
+	evictions atomic.Uint64
+}
+
+// CacheStats returns the hits, misses and evictions of the country's year cache
+func (c *Country) CacheStats() CacheStats {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return CacheStats{
+		Years:     len(c.years),
+		Hits:      c.cache.hits.Load(),
+		Misses:    c.cache.misses.Load(),
+		Evictions: c.cache.evictions.Load(),
+	}
+}
+
+// cacheHit records a lookup answered from a loaded year (caller must hold a lock)
+func (c *Country) cacheHit(year int) {
+	c.cache.hits.Add(1)
+	if used := c.cache.lastUsed[year]; used != nil {
+		used.Store(c.cache.clock.Add(1))
+	}
+}
+
+// cacheLoaded records that year was just loaded and evicts the least recently used
+// years beyond the limit (caller must hold the write lock)
+func (c *Country) cacheLoaded(year int) {
+	c.cache.misses.Add(1)
+	if c.cache.lastUsed == nil {
+		c.cache.lastUsed = make(map[int]*atomic.Uint64)
+	}
+	used := &atomic.Uint64{}
+	used.Store(c.cache.clock.Add(1))
+	c.cache.lastUsed[year] = used
+
+	for c.cache.maxYears > 0 && len(c.years) > c.cache.maxYears {
+		oldest, oldestUsed := 0, uint64(0)
+		found := false
+		for loaded := range c.years {
+			if loaded == year {
+				continue
+			}
+			var when uint64
+			if used := c.cache.lastUsed[loaded]; used != nil {
+				when = used.Load()
+			}
+			if !found || when < oldestUsed {
+				oldest, oldestUsed, found = loaded, when, true
+			}
+		}
+		if !found {
+			return
+		}
+		delete(c.years, oldest)
+		delete(c.extras, oldest)
+		delete(c.cache.lastUsed, oldest)
+		c.cache.evictions.Add(1)
+	}
+}
+
+// resetYears drops every loaded year, so each reloads through the full pipeline with the
+// current configuration (caller must hold the write lock). Readers still holding a
+// dropped year's map are unaffected, as it is never changed again.
+func (c *Country) resetYears() {
+	c.years = make(map[int]map[time.Time]*Holiday)
+	c.extras = make(map[int]map[time.Time][]*Holiday)
+	c.resetCache()
+}
+
+// resetCache forgets the recorded use of every year after the years are cleared
+// (caller must hold the write lock)
+func (c *Country) resetCache() {
+	c.cache.lastUsed = nil
+}
@@ -0,0 +1,100 @@
+package goholidays
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCacheEviction(t *testing.T) {
+	us := NewCountry("US", CountryOptions{MaxCachedYears: 2})
+
+	us.HolidaysForYear(2023)
+	us.HolidaysForYear(2024)
+	us.HolidaysForYear(2023) // 2024 is now the least recently used
+	us.HolidaysForYear(2025)
+
+	stats := us.CacheStats()
+	if stats.Years != 2 || stats.Evictions != 1 {
+		t.Fatalf("Expected 2 years loaded after 1 eviction, got %+v", stats)
+	}
+	if stats.Hits != 1 || stats.Misses != 3 {
+		t.Errorf("Expected 1 hit and 3 misses, got %+v", stats)
+	}
+
+	us.mu.RLock()
+	_, has2023 := us.years[2023]
+	_, has2024 := us.years[2024]
+	us.mu.RUnlock()
+	if !has2023 || has2024 {
+		t.Errorf("Expected 2024 to be evicted and 2023 kept, got 2023=%v 2024=%v", has2023, has2024)
+	}
+
+	// An evicted year is loaded again on demand
+	if _, ok := us.IsHoliday(time.Date(2024, 7, 4, 0, 0, 0, 0, time.UTC)); !ok {
+		t.Error("Expected Independence Day after reloading an evicted year")
+	}
+	if stats := us.CacheStats(); stats.Misses != 4 || stats.Evictions != 2 {
+		t.Errorf("Expected the reload to count a miss and an eviction, got %+v", stats)
+	}
+}
+
+func TestCacheUnbounded(t *testing.T) {
+	us := NewCountry("US")
+	for year := 2000; year < 2050; year++ {
+		us.HolidaysForYear(year)
+	}
+	if stats := us.CacheStats(); stats.Years != 50 || stats.Evictions != 0 {
+		t.Errorf("Expected every year kept without MaxCachedYears, got %+v", stats)
+	}
+}
+
+func TestCacheConcurrent(t *testing.T) {
+	us := NewCountry("US", CountryOptions{MaxCachedYears: 3})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				year := 2000 + (i+j)%10
+				if _, ok := us.IsHoliday(time.Date(year, 12, 25, 0, 0, 0, 0, time.UTC)); !ok {
+					t.Errorf("Expected Christmas Day in %d", year)
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if stats := us.CacheStats(); stats.Years > 3 || stats.Hits+stats.Misses != 400 {
+		t.Errorf("Expected at most 3 years and 400 lookups, got %+v", stats)
+	}
+}
+
+func TestCacheEvictionDuringLookup(t *testing.T) {
+	us := NewCountry("US", CountryOptions{MaxCachedYears: 1})
+
+	// Each lookup evicts the year another goroutine may have just loaded
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				year := 2000 + (i+j)%10
+				christmas := time.Date(year, 12, 25, 0, 0, 0, 0, time.UTC)
+				if len(us.HolidaysOn(christmas)) == 0 {
+					t.Errorf("Expected HolidaysOn to find Christmas Day in %d", year)
+				}
+				if !us.HasHolidayInRange(christmas, christmas) {
+					t.Errorf("Expected HasHolidayInRange to find Christmas Day in %d", year)
+				}
+				if len(us.HolidayDates(year, "Christmas Day")) == 0 {
+					t.Errorf("Expected HolidayDates to find Christmas Day in %d", year)
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,118 @@
+package goholidays
+
+import (
+	"fmt"
+	"html"
+	"strings"
+	"time"
+)
+
+// Inline styles of RenderMonthHTML, so the output needs no stylesheet
+const (
+	htmlTableStyle   = "border-collapse:collapse;font-family:sans-serif;font-size:14px"
+	htmlCellStyle    = "border:1px solid #ccc;padding:4px;width:80px;height:48px;vertical-align:top"
+	htmlWeekendStyle = "background:#f0f0f0;color:#757575"
+	htmlHolidayStyle = "background:#ffebee;color:#d32f2f;font-weight:bold"
+)
+
+// RenderMonthHTML returns a month grid as a self-contained HTML table with inline
+// styles, suitable for pasting into an email or report. Weekends are shaded grey and
+// holidays red, each holiday showing its name in the cell and as a tooltip. Month,
+// weekday and holiday names follow the country's language when translated.
+func (hc *HolidayCalendar) RenderMonthHTML(year int, month time.Month) string {
+	language := hc.country.GetLanguage()
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "<table style=\"%s\">\n", htmlTableStyle)
+	fmt.Fprintf(&b, "<caption style=\"font-weight:bold;padding:4px\">%s %d</caption>\n",
+		html.EscapeString(MonthName(month, language)), year)
+	b.WriteString("<tr>")
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		fmt.Fprintf(&b, "<th style=\"%s;height:auto\">%s</th>", htmlCellStyle, html.EscapeString(WeekdayAbbrev(day, language)))
+	}
+	b.WriteString("</tr>\n")
+
+	for _, week := range hc.weeks(year, month) {
+		b.WriteString("<tr>")
+		for _, entry := range week {
+			switch {
+			case entry == nil:
+				fmt.Fprintf(&b, "<td style=\"%s\"></td>", htmlCellStyle)
+			case entry.IsHoliday:
+				name := html.EscapeString(hc.country.HolidayName(entry.Holiday, language))
+				fmt.Fprintf(&b, "<td style=\"%s;%s\" title=\"%s\">%d<br><small>%s</small></td>",
+					htmlCellStyle, htmlHolidayStyle, name, entry.Date.Day(), name)
+			case entry.IsWeekend:
+				fmt.Fprintf(&b, "<td style=\"%s;%s\">%d</td>", htmlCellStyle, htmlWeekendStyle, entry.Date.Day())
+			default:
+				fmt.Fprintf(&b, "<td style=\"%s\">%d</td>", htmlCellStyle, entry.Date.Day())
+			}
+		}
+		b.WriteString("</tr>\n")
+	}
+
+	b.WriteString("</table>\n")
+	return b.String()
+}
+
+// RenderMonthMarkdown returns a month grid as a Markdown table. Holidays are bold with a
+// numbered note listing their names below the table, and weekends are italic. Month,
+// weekday and holiday names follow the country's language when translated.
+func (hc *HolidayCalendar) RenderMonthMarkdown(year int, month time.Month) string {
+	language := hc.country.GetLanguage()
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "**%s %d**\n\n", MonthName(month, language), year)
+	header := make([]string, 7)
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		header[day] = WeekdayAbbrev(day, language)
+	}
+	fmt.Fprintf(&b, "| %s |\n", strings.Join(header, " | "))
+	b.WriteString(strings.Repeat("|:-:", 7) + "|\n")
+
+	var notes []string
+	for _, week := range hc.weeks(year, month) {
+		cells := make([]string, 7)
+		for i, entry := range week {
+			switch {
+			case entry == nil:
+				cells[i] = " "
+			case entry.IsHoliday:
+				notes = append(notes, fmt.Sprintf("%d. %s %d: %s", len(notes)+1, MonthName(month, language),
+					entry.Date.Day(), hc.country.HolidayName(entry.Holiday, language)))
+				cells[i] = fmt.Sprintf("**%d** [%d]", entry.Date.Day(), len(notes))
+			case entry.IsWeekend:
+				cells[i] = fmt.Sprintf("_%d_", entry.Date.Day())
+			default:
+				cells[i] = fmt.Sprintf("%d", entry.Date.Day())
+			}
+		}
+		fmt.Fprintf(&b, "| %s |\n", strings.Join(cells, " | "))
+	}
+
+	if len(notes) > 0 {
+		b.WriteString("\n")
+		for _, note := range notes {
+			b.WriteString(strings.ReplaceAll(note, "|", "\\|") + "\n")
+		}
+	}
+	return b.String()
+}
+
+// weeks splits the month's calendar entries into Sunday-first weeks, padding the first
+// and last week with nil entries
+func (hc *HolidayCalendar) weeks(year int, month time.Month) [][7]*CalendarEntry {
+	entries := hc.GenerateMonth(year, month)
+
+	var weeks [][7]*CalendarEntry
+	var week [7]*CalendarEntry
+	for i := range entries {
+		weekday := entries[i].Date.Weekday()
+		week[weekday] = &entries[i]
+		if weekday == time.Saturday || i == len(entries)-1 {
+			weeks = append(weeks, week)
+			week = [7]*CalendarEntry{}
+		}
+	}
+	return weeks
+}
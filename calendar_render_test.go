@@ -0,0 +1,52 @@
+package goholidays
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRenderMonthHTML(t *testing.T) {
+	cal := NewHolidayCalendar(NewCountry("US"))
+	out := cal.RenderMonthHTML(2024, time.July)
+
+	if !strings.HasPrefix(out, "<table") || !strings.HasSuffix(out, "</table>\n") {
+		t.Errorf("Expected a single HTML table, got %q", out)
+	}
+	if !strings.Contains(out, `title="Independence Day">4<br>`) {
+		t.Error("Expected July 4th highlighted with its name as tooltip")
+	}
+	if !strings.Contains(out, htmlWeekendStyle+`">6</td>`) {
+		t.Error("Expected Saturday July 6th styled as a weekend")
+	}
+	if strings.Contains(out, "<style") || strings.Contains(out, "class=") {
+		t.Error("Expected inline styles only")
+	}
+
+	fr := NewHolidayCalendar(NewCountry("FR", CountryOptions{Language: "fr"}))
+	if out := fr.RenderMonthHTML(2024, time.May); !strings.Contains(out, "mai 2024") || !strings.Contains(out, `title="Fête du Travail"`) {
+		t.Error("Expected French month and holiday names")
+	}
+}
+
+func TestRenderMonthMarkdown(t *testing.T) {
+	cal := NewHolidayCalendar(NewCountry("US"))
+	out := cal.RenderMonthMarkdown(2024, time.July)
+
+	for _, want := range []string{
+		"| Su | Mo | Tu | We | Th | Fr | Sa |",
+		"**4** [1]",
+		"_6_",
+		"1. July 4: Independence Day",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected output to contain %q, got:\n%s", want, out)
+		}
+	}
+
+	// Each week row plus the header and separator
+	rows := strings.Count(out, "|\n")
+	if rows != 2+5 {
+		t.Errorf("Expected 7 table rows for July 2024, got %d", rows)
+	}
+}
@@ -0,0 +1,44 @@
+package goholidays
+
+// CategoryDisplay describes how a front-end can present holidays of a category
+type CategoryDisplay struct {
+	Label string // Human-readable category name
+	Icon  string // Emoji shown next to holidays of the category
+	Color string // Suggested color, as a #RRGGBB hex string
+}
+
+// CategoryDisplays holds the display metadata of every standard HolidayCategory. Front-ends
+// can override an entry, or add one for a provider-specific category, by assigning to the
+// map during initialization.
+var CategoryDisplays = map[HolidayCategory]CategoryDisplay{
+	CategoryPublic:      {Label: "Public holiday", Icon: "🏛️", Color: "#D32F2F"},
+	CategoryBank:        {Label: "Bank holiday", Icon: "🏦", Color: "#1976D2"},
+	CategorySchool:      {Label: "School holiday", Icon: "🎒", Color: "#FBC02D"},
+	CategoryGovernment:  {Label: "Government holiday", Icon: "🏢", Color: "#455A64"},
+	CategoryReligious:   {Label: "Religious holiday", Icon: "🕊️", Color: "#7B1FA2"},
+	CategoryOptional:    {Label: "Optional holiday", Icon: "📌", Color: "#9E9E9E"},
+	CategoryHalfDay:     {Label: "Half day", Icon: "🌗", Color: "#F57C00"},
+	CategoryArmedForces: {Label: "Armed forces holiday", Icon: "🎖️", Color: "#388E3C"},
+	CategoryWorkday:     {Label: "Working day", Icon: "💼", Color: "#616161"},
+}
+
+// defaultCategoryDisplay is used for categories without an entry in CategoryDisplays
+var defaultCategoryDisplay = CategoryDisplay{Label: "Holiday", Icon: "📅", Color: "#757575"}
+
+// DisplayForCategory returns the display metadata of category. A provider-specific
+// category without its own entry, such as "federal", uses that of the standard category
+// it belongs to; any other category gets a generic calendar entry labelled with its name.
+func DisplayForCategory(category HolidayCategory) CategoryDisplay {
+	if display, exists := CategoryDisplays[category]; exists {
+		return display
+	}
+	if display, exists := CategoryDisplays[canonicalCategory(category)]; exists {
+		return display
+	}
+
+	display := defaultCategoryDisplay
+	if category != "" {
+		display.Label = string(category)
+	}
+	return display
+}
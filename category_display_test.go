@@ -0,0 +1,42 @@
+package goholidays
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestCategoryDisplays(t *testing.T) {
+	categories := []HolidayCategory{
+		CategoryPublic, CategoryBank, CategorySchool, CategoryGovernment, CategoryReligious,
+		CategoryOptional, CategoryHalfDay, CategoryArmedForces, CategoryWorkday,
+	}
+	color := regexp.MustCompile(`^#[0-9A-F]{6}$`)
+
+	for _, category := range categories {
+		display, exists := CategoryDisplays[category]
+		if !exists {
+			t.Errorf("Expected display metadata for category %s", category)
+			continue
+		}
+		if display.Label == "" || display.Icon == "" || !color.MatchString(display.Color) {
+			t.Errorf("Incomplete display metadata for category %s: %+v", category, display)
+		}
+	}
+}
+
+func TestDisplayForCategory(t *testing.T) {
+	if got := DisplayForCategory(CategoryFederal); got != CategoryDisplays[CategoryPublic] {
+		t.Errorf("Expected federal holidays to display as public ones, got %+v", got)
+	}
+	if got := DisplayForCategory("memorial"); got.Label != "memorial" || got.Icon != defaultCategoryDisplay.Icon {
+		t.Errorf("Expected a generic entry labelled memorial, got %+v", got)
+	}
+
+	// Overrides replace the default for every lookup
+	original := CategoryDisplays[CategoryBank]
+	t.Cleanup(func() { CategoryDisplays[CategoryBank] = original })
+	CategoryDisplays[CategoryBank] = CategoryDisplay{Label: "Market holiday", Icon: "📈", Color: "#00897B"}
+	if got := DisplayForCategory(CategoryBank); got.Label != "Market holiday" {
+		t.Errorf("Expected the overridden bank display, got %+v", got)
+	}
+}
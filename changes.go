@@ -0,0 +1,93 @@
+package goholidays
+
+import (
+	_ "embed"
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// HolidayChangeKind describes how a release changed the holidays a country reports
+type HolidayChangeKind string
+
+const (
+	// ChangeCountryAdded marks a country that gained a provider
+	ChangeCountryAdded HolidayChangeKind = "country_added"
+	// ChangeAdded marks a holiday that was added to a country
+	ChangeAdded HolidayChangeKind = "added"
+	// ChangeRemoved marks a holiday that is no longer reported
+	ChangeRemoved HolidayChangeKind = "removed"
+	// ChangeRenamed marks a holiday reported under a new name
+	ChangeRenamed HolidayChangeKind = "renamed"
+	// ChangeChanged marks a holiday whose dates or status changed
+	ChangeChanged HolidayChangeKind = "changed"
+)
+
+// HolidayChange is one change to the holiday data made in a release
+type HolidayChange struct {
+	Version      string            `json:"version"`
+	Kind         HolidayChangeKind `json:"kind"`
+	Country      string            `json:"country"`
+	Name         string            `json:"name,omitempty"`
+	PreviousName string            `json:"previous_name,omitempty"`
+	Description  string            `json:"description,omitempty"`
+}
+
+// holidayChangesData lists every HolidayChange in release order. Add an entry for each
+// change to the holidays reported when releasing a new Version.
+//
+//go:embed holiday_changes.json
+var holidayChangesData []byte
+
+// HolidayChangesSince returns the changes made in releases after version, up to and
+// including the current Version, in release order. A version that cannot be parsed,
+// such as an empty string, returns every recorded change.
+func HolidayChangesSince(version string) []HolidayChange {
+	var all []HolidayChange
+	if err := json.Unmarshal(holidayChangesData, &all); err != nil {
+		return nil
+	}
+
+	since, ok := parseVersion(version)
+	if !ok {
+		since = [3]int{}
+	}
+
+	var changes []HolidayChange
+	for _, change := range all {
+		if changeVersion, ok := parseVersion(change.Version); ok && compareVersions(changeVersion, since) > 0 {
+			changes = append(changes, change)
+		}
+	}
+	return changes
+}
+
+// parseVersion parses a MAJOR.MINOR.PATCH version, with or without a leading "v"
+func parseVersion(version string) ([3]int, bool) {
+	var parsed [3]int
+	parts := strings.Split(strings.TrimPrefix(version, "v"), ".")
+	if len(parts) != 3 {
+		return parsed, false
+	}
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return parsed, false
+		}
+		parsed[i] = n
+	}
+	return parsed, true
+}
+
+// compareVersions returns -1, 0 or 1 as a is older than, equal to or newer than b
+func compareVersions(a, b [3]int) int {
+	for i := range a {
+		if a[i] != b[i] {
+			if a[i] < b[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
@@ -0,0 +1,83 @@
+package goholidays
+
+import "testing"
+
+func TestHolidayChangesSince(t *testing.T) {
+	changes := HolidayChangesSince("0.6.2")
+
+	found := false
+	for _, change := range changes {
+		if change.Kind == ChangeCountryAdded && change.Country == "CL" && change.Version == "0.6.3" {
+			found = true
+		}
+		if change.Version == "0.6.2" || change.Version == "0.5.3" {
+			t.Errorf("Expected only changes after 0.6.2, got %+v", change)
+		}
+	}
+	if !found {
+		t.Error("Expected the Chile provider added in 0.6.3 to be reported")
+	}
+
+	renamed := false
+	for _, change := range HolidayChangesSince("v0.6.3") {
+		if change.Kind == ChangeRenamed && change.Country == "AU" && change.PreviousName == "Queen's Birthday" && change.Name == "King's Birthday" {
+			renamed = true
+		}
+	}
+	if !renamed {
+		t.Error("Expected the Australian King's Birthday rename to be reported since v0.6.3")
+	}
+
+	datasets := 0
+	for _, change := range HolidayChangesSince("0.6.5") {
+		if change.Kind == ChangeCountryAdded && (change.Country == "DK" || change.Country == "LU") {
+			datasets++
+		}
+	}
+	if datasets != 2 {
+		t.Errorf("Expected the DK and LU datasets to be reported since the 0.6.5 release, got %d", datasets)
+	}
+
+	if latest := HolidayChangesSince(Version); len(latest) != 0 {
+		t.Errorf("Expected no changes since the current version, got %v", latest)
+	}
+	if all := HolidayChangesSince(""); len(all) <= len(changes) {
+		t.Errorf("Expected an unparseable version to return every change, got %d", len(all))
+	}
+}
+
+func TestHolidayChangesData(t *testing.T) {
+	current, ok := parseVersion(Version)
+	if !ok {
+		t.Fatalf("Version %q does not parse", Version)
+	}
+
+	kinds := map[HolidayChangeKind]bool{
+		ChangeCountryAdded: true, ChangeAdded: true, ChangeRemoved: true, ChangeRenamed: true, ChangeChanged: true,
+	}
+
+	var previous [3]int
+	for _, change := range HolidayChangesSince("") {
+		version, ok := parseVersion(change.Version)
+		if !ok {
+			t.Errorf("Change %+v has an invalid version", change)
+			continue
+		}
+		if compareVersions(version, previous) < 0 {
+			t.Errorf("Change %+v is out of release order", change)
+		}
+		if compareVersions(version, current) > 0 {
+			t.Errorf("Change %+v is newer than Version %s", change, Version)
+		}
+		if !kinds[change.Kind] {
+			t.Errorf("Change %+v has an unknown kind", change)
+		}
+		if !SupportedCountries[change.Country] {
+			t.Errorf("Change %+v names an unsupported country", change)
+		}
+		if change.Kind == ChangeRenamed && (change.Name == "" || change.PreviousName == "") {
+			t.Errorf("Rename %+v needs both names", change)
+		}
+		previous = version
+	}
+}
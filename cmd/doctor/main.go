@@ -0,0 +1,92 @@
+// Command doctor reports how many holidays each supported country actually loads,
+// flagging countries that are listed in SupportedCountries but have no provider and
+// dates on which a country loads more than one holiday.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	goholidays "github.com/coredds/goholiday"
+)
+
+// For testing
+var osExit = os.Exit
+
+// coverage is the number of holidays a supported country loads for a year
+type coverage struct {
+	Code       string
+	Holidays   int
+	Duplicates []goholidays.DuplicateWarning
+}
+
+func main() {
+	var (
+		year   = flag.Int("year", time.Now().Year(), "Year to load for each country")
+		strict = flag.Bool("strict", false, "Exit with status 1 if any supported country loads no holidays")
+	)
+	flag.Parse()
+
+	// Providers log sparse-data warnings; keep the report itself readable
+	log.SetOutput(io.Discard)
+
+	rows := checkCoverage(*year)
+	missing := printCoverage(os.Stdout, rows, *year)
+
+	if *strict && missing > 0 {
+		osExit(1)
+	}
+}
+
+// checkCoverage loads year for every supported country, ordered by country code
+func checkCoverage(year int) []coverage {
+	codes := goholidays.GetSupportedCountries()
+	sort.Strings(codes)
+
+	rows := make([]coverage, 0, len(codes))
+	for _, code := range codes {
+		country := goholidays.NewCountry(code)
+		rows = append(rows, coverage{
+			Code:       code,
+			Holidays:   len(country.HolidaysForYear(year)),
+			Duplicates: country.DetectDuplicates(year),
+		})
+	}
+	return rows
+}
+
+// printCoverage writes the coverage table to out and returns how many countries loaded no holidays
+func printCoverage(out io.Writer, rows []coverage, year int) int {
+	var missing []string
+
+	fmt.Fprintf(out, "Provider coverage for %d\n", year)
+	fmt.Fprintln(out, "Code  Holidays  Status")
+	fmt.Fprintln(out, strings.Repeat("-", 25))
+	for _, row := range rows {
+		status := "ok"
+		if row.Holidays == 0 {
+			status = "MISSING"
+			missing = append(missing, row.Code)
+		}
+		fmt.Fprintf(out, "%-4s  %8d  %s\n", row.Code, row.Holidays, status)
+	}
+
+	fmt.Fprintf(out, "\n%d of %d supported countries load holidays\n", len(rows)-len(missing), len(rows))
+	if len(missing) > 0 {
+		fmt.Fprintf(out, "Claimed but unimplemented: %s\n", strings.Join(missing, ", "))
+	}
+
+	for _, row := range rows {
+		for _, duplicate := range row.Duplicates {
+			fmt.Fprintf(out, "Several holidays on %s %s: %s\n",
+				row.Code, duplicate.Date.Format("2006-01-02"), strings.Join(duplicate.Names, ", "))
+		}
+	}
+	return len(missing)
+}
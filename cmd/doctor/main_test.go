@@ -0,0 +1,60 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	goholidays "github.com/coredds/goholiday"
+)
+
+func TestCheckCoverage(t *testing.T) {
+	rows := checkCoverage(2024)
+
+	if len(rows) != len(goholidays.SupportedCountries) {
+		t.Fatalf("Expected a row for each of %d supported countries, got %d", len(goholidays.SupportedCountries), len(rows))
+	}
+
+	for i := 1; i < len(rows); i++ {
+		if rows[i-1].Code >= rows[i].Code {
+			t.Errorf("Rows not sorted by code: %s before %s", rows[i-1].Code, rows[i].Code)
+		}
+	}
+
+	for _, row := range rows {
+		if row.Code == "US" && row.Holidays == 0 {
+			t.Error("Expected US to load holidays")
+		}
+	}
+}
+
+func TestPrintCoverage(t *testing.T) {
+	rows := []coverage{
+		{Code: "AT", Holidays: 0},
+		{Code: "US", Holidays: 11, Duplicates: []goholidays.DuplicateWarning{
+			{Date: time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC), Names: []string{"Christmas Day", "Christmas"}},
+		}},
+		{Code: "PL", Holidays: 0},
+	}
+
+	var buf bytes.Buffer
+	missing := printCoverage(&buf, rows, 2024)
+	output := buf.String()
+
+	if missing != 2 {
+		t.Errorf("Expected 2 missing countries, got %d", missing)
+	}
+	if !strings.Contains(output, "US          11  ok") {
+		t.Errorf("Expected US row in output, got:\n%s", output)
+	}
+	if !strings.Contains(output, "AT           0  MISSING") {
+		t.Errorf("Expected AT to be flagged, got:\n%s", output)
+	}
+	if !strings.Contains(output, "Claimed but unimplemented: AT, PL") {
+		t.Errorf("Expected summary of missing countries, got:\n%s", output)
+	}
+	if !strings.Contains(output, "Several holidays on US 2024-12-25: Christmas Day, Christmas") {
+		t.Errorf("Expected duplicate dates to be reported, got:\n%s", output)
+	}
+}
@@ -130,60 +130,41 @@ func showCalendar(country *goholidays.Country, year int, month time.Month) {
 }
 
 func listHolidaysForYear(country *goholidays.Country, year int, format string) {
-	holidays := country.HolidaysForYear(year)
-
 	switch format {
 	case "json":
-		if err := json.NewEncoder(os.Stdout).Encode(holidays); err != nil {
+		if err := json.NewEncoder(os.Stdout).Encode(country.HolidaysForYear(year)); err != nil {
 			fmt.Fprintf(os.Stderr, "Error encoding JSON output: %v\n", err)
 			osExit(1)
 		}
 	case "csv":
 		fmt.Println("Date,Name,Category,Observed")
-		for date, holiday := range holidays {
+		for _, holiday := range country.SortedHolidaysForYear(year) {
 			observed := ""
 			if holiday.IsObserved && holiday.Observed != nil {
 				observed = holiday.Observed.Format("2006-01-02")
 			}
 			fmt.Printf("%s,%s,%s,%s\n",
-				date.Format("2006-01-02"),
+				holiday.Date.Format("2006-01-02"),
 				holiday.Name,
 				holiday.Category,
 				observed)
 		}
 	default:
 		fmt.Printf("Holidays for %s in %d:\n\n", country.GetCountryCode(), year)
-		fmt.Printf("%-12s %-30s %-12s %-12s\n", "Date", "Holiday", "Category", "Observed")
+		// Names are padded by display width, so wide characters such as CJK keep the columns aligned
+		fmt.Printf("%-12s %s %-12s %-12s\n", "Date", goholidays.PadToWidth("Holiday", 30), "Category", "Observed")
 		fmt.Println(strings.Repeat("-", 70))
 
-		// Convert map to slice for sorting
-		type holidayDate struct {
-			date    time.Time
-			holiday *goholidays.Holiday
-		}
-		var sortedHolidays []holidayDate
-		for date, holiday := range holidays {
-			sortedHolidays = append(sortedHolidays, holidayDate{date, holiday})
-		}
-
-		// Simple sort by date
-		for i := 0; i < len(sortedHolidays); i++ {
-			for j := i + 1; j < len(sortedHolidays); j++ {
-				if sortedHolidays[i].date.After(sortedHolidays[j].date) {
-					sortedHolidays[i], sortedHolidays[j] = sortedHolidays[j], sortedHolidays[i]
-				}
-			}
-		}
-
-		for _, hd := range sortedHolidays {
+		for _, holiday := range country.SortedHolidaysForYear(year) {
 			observed := ""
-			if hd.holiday.IsObserved && hd.holiday.Observed != nil {
-				observed = hd.holiday.Observed.Format("01-02")
+			if holiday.IsObserved && holiday.Observed != nil {
+				observed = holiday.Observed.Format("01-02")
 			}
-			fmt.Printf("%-12s %-30s %-12s %-12s\n",
-				hd.date.Format("2006-01-02"),
-				hd.holiday.Name,
-				hd.holiday.Category,
+			name := country.HolidayName(holiday, country.GetLanguage())
+			fmt.Printf("%-12s %s %-12s %-12s\n",
+				holiday.Date.Format("2006-01-02"),
+				goholidays.PadToWidth(name, 30),
+				holiday.Category,
 				observed)
 		}
 	}
@@ -155,6 +155,27 @@ func TestListHolidaysForYear(t *testing.T) {
 			t.Error("CSV should have correct header")
 		}
 	})
+
+	// Test alignment of wide holiday names
+	t.Run("Table Alignment", func(t *testing.T) {
+		categoryColumn := func(language, name string) int {
+			jp := goholidays.NewCountry("JP", goholidays.CountryOptions{Language: language})
+			output := captureOutput(func() {
+				listHolidaysForYear(jp, year, "table")
+			})
+			for _, line := range strings.Split(output, "\n") {
+				if strings.HasPrefix(line, "2024-01-01") && strings.Contains(line, name) {
+					return goholidays.DisplayWidth(line[:strings.Index(line, "public")])
+				}
+			}
+			t.Fatalf("Expected a row for %s, got:\n%s", name, output)
+			return 0
+		}
+
+		if ja, en := categoryColumn("ja", "元日"), categoryColumn("en", "New Year's Day"); ja != en {
+			t.Errorf("Expected the category column at the same width for 元日 and New Year's Day, got %d and %d", ja, en)
+		}
+	})
 }
 
 func TestShowCalendar(t *testing.T) {
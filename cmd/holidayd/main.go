@@ -0,0 +1,197 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	goholidays "github.com/coredds/goholiday"
+)
+
+// shutdownTimeout bounds how long in-flight requests may run after a shutdown signal
+var shutdownTimeout = 10 * time.Second
+
+// holidayResponse is the JSON form of a holiday, named in the requested language
+type holidayResponse struct {
+	Date         string   `json:"date"`
+	Name         string   `json:"name"`
+	Category     string   `json:"category"`
+	Observed     string   `json:"observed,omitempty"`
+	Subdivisions []string `json:"subdivisions,omitempty"`
+}
+
+// errorResponse is the JSON body of a failed request
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+func main() {
+	addr := flag.String("addr", ":8080", "Address to listen on")
+	flag.Parse()
+
+	server := &http.Server{
+		Addr:              *addr,
+		Handler:           newHandler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	errCh := make(chan error, 1)
+	go func() {
+		log.Printf("holidayd listening on %s", *addr)
+		errCh <- server.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errCh:
+		if !errors.Is(err, http.ErrServerClosed) {
+			log.Fatalf("Server failed: %v", err)
+		}
+	case <-ctx.Done():
+		log.Println("Shutting down...")
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			log.Fatalf("Shutdown failed: %v", err)
+		}
+	}
+}
+
+// newHandler returns the handler serving the /v1 API
+func newHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/holidays", handleHolidays)
+	mux.HandleFunc("GET /v1/is-holiday", handleIsHoliday)
+	mux.HandleFunc("GET /v1/countries", handleCountries)
+	return mux
+}
+
+// handleHolidays serves GET /v1/holidays?country=US&year=2024
+func handleHolidays(w http.ResponseWriter, r *http.Request) {
+	country, err := countryFromQuery(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	year := time.Now().Year()
+	if value := r.URL.Query().Get("year"); value != "" {
+		if year, err = strconv.Atoi(value); err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("invalid year %q", value))
+			return
+		}
+	}
+	if err := goholidays.ValidateYear(year); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	holidays := make([]holidayResponse, 0)
+	for _, holiday := range country.SortedHolidaysForYear(year) {
+		holidays = append(holidays, toResponse(country, holiday))
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"country":  country.GetCountryCode(),
+		"year":     year,
+		"holidays": holidays,
+	})
+}
+
+// handleIsHoliday serves GET /v1/is-holiday?country=US&date=2024-07-04
+func handleIsHoliday(w http.ResponseWriter, r *http.Request) {
+	country, err := countryFromQuery(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	dateStr := r.URL.Query().Get("date")
+	if dateStr == "" {
+		writeError(w, http.StatusBadRequest, errors.New("date is required (YYYY-MM-DD)"))
+		return
+	}
+	holiday, isHoliday, err := country.IsHolidayStr(dateStr)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	result := map[string]interface{}{
+		"country":    country.GetCountryCode(),
+		"date":       dateStr,
+		"is_holiday": isHoliday,
+	}
+	if isHoliday {
+		result["holiday"] = toResponse(country, holiday)
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+// handleCountries serves GET /v1/countries
+func handleCountries(w http.ResponseWriter, r *http.Request) {
+	codes := goholidays.GetSupportedCountries()
+	sort.Strings(codes)
+	writeJSON(w, http.StatusOK, map[string]interface{}{"countries": codes})
+}
+
+// countryFromQuery creates the Country named by the country query parameter, using the
+// optional language and comma-separated subdivisions parameters as its options
+func countryFromQuery(r *http.Request) (*goholidays.Country, error) {
+	query := r.URL.Query()
+	code := strings.ToUpper(strings.TrimSpace(query.Get("country")))
+	if code == "" {
+		return nil, errors.New("country is required")
+	}
+
+	options := goholidays.CountryOptions{Language: query.Get("language")}
+	if subdivisions := query.Get("subdivisions"); subdivisions != "" {
+		for _, sub := range strings.Split(subdivisions, ",") {
+			if sub = strings.TrimSpace(sub); sub != "" {
+				options.Subdivisions = append(options.Subdivisions, sub)
+			}
+		}
+	}
+	return goholidays.NewCountryWithError(code, options)
+}
+
+// toResponse converts a holiday of country, named in the country's language
+func toResponse(country *goholidays.Country, holiday *goholidays.Holiday) holidayResponse {
+	response := holidayResponse{
+		Date:         holiday.Date.Format("2006-01-02"),
+		Name:         country.HolidayName(holiday, country.GetLanguage()),
+		Category:     string(holiday.Category),
+		Subdivisions: holiday.Subdivisions,
+	}
+	if holiday.IsObserved && holiday.Observed != nil {
+		response.Observed = holiday.Observed.Format("2006-01-02")
+	}
+	return response
+}
+
+// writeJSON writes v as the JSON response body with the given status
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Printf("Error encoding JSON response: %v", err)
+	}
+}
+
+// writeError writes err as a JSON error response with the given status
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, errorResponse{Error: err.Error()})
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// get performs a request against the API handler and decodes its JSON body
+func get(t *testing.T, target string) (int, map[string]interface{}) {
+	t.Helper()
+
+	recorder := httptest.NewRecorder()
+	newHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, target, nil))
+
+	if contentType := recorder.Header().Get("Content-Type"); contentType != "application/json" {
+		t.Errorf("%s: Content-Type = %q, expected application/json", target, contentType)
+	}
+	var body map[string]interface{}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &body); err != nil {
+		t.Fatalf("%s: failed to parse JSON response: %v", target, err)
+	}
+	return recorder.Code, body
+}
+
+func TestHolidaysEndpoint(t *testing.T) {
+	t.Run("Year", func(t *testing.T) {
+		status, body := get(t, "/v1/holidays?country=us&year=2024")
+		if status != http.StatusOK {
+			t.Fatalf("Expected status 200, got %d: %v", status, body)
+		}
+		if body["country"] != "US" {
+			t.Errorf("Expected country US, got %v", body["country"])
+		}
+
+		holidays := body["holidays"].([]interface{})
+		if len(holidays) == 0 {
+			t.Fatal("Expected holidays for US 2024")
+		}
+		first := holidays[0].(map[string]interface{})
+		if first["date"] != "2024-01-01" || first["name"] != "New Year's Day" {
+			t.Errorf("Expected New Year's Day first, got %v", first)
+		}
+	})
+
+	t.Run("Language", func(t *testing.T) {
+		_, body := get(t, "/v1/holidays?country=FR&year=2024&language=fr")
+		first := body["holidays"].([]interface{})[0].(map[string]interface{})
+		if first["name"] != "Jour de l'An" {
+			t.Errorf("Expected the French name, got %v", first["name"])
+		}
+	})
+
+	t.Run("Subdivisions", func(t *testing.T) {
+		_, national := get(t, "/v1/holidays?country=US&year=2024")
+		_, regional := get(t, "/v1/holidays?country=US&year=2024&subdivisions=MA")
+		if len(regional["holidays"].([]interface{})) <= len(national["holidays"].([]interface{})) {
+			t.Error("Expected Massachusetts to add regional holidays")
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		for _, target := range []string{
+			"/v1/holidays?year=2024",
+			"/v1/holidays?country=XX&year=2024",
+			"/v1/holidays?country=US&year=abc",
+			"/v1/holidays?country=US&year=1800",
+		} {
+			status, body := get(t, target)
+			if status != http.StatusBadRequest {
+				t.Errorf("%s: expected status 400, got %d", target, status)
+			}
+			if body["error"] == nil {
+				t.Errorf("%s: expected an error message", target)
+			}
+		}
+	})
+}
+
+func TestIsHolidayEndpoint(t *testing.T) {
+	status, body := get(t, "/v1/is-holiday?country=US&date=2024-07-04")
+	if status != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %v", status, body)
+	}
+	if body["is_holiday"] != true {
+		t.Error("July 4th should be marked as holiday")
+	}
+	if holiday := body["holiday"].(map[string]interface{}); holiday["name"] != "Independence Day" {
+		t.Errorf("Expected Independence Day, got %v", holiday["name"])
+	}
+
+	_, body = get(t, "/v1/is-holiday?country=US&date=2024-07-05")
+	if body["is_holiday"] != false || body["holiday"] != nil {
+		t.Errorf("July 5th should not be a holiday, got %v", body)
+	}
+
+	if status, _ := get(t, "/v1/is-holiday?country=US&date=07/04/2024"); status != http.StatusBadRequest {
+		t.Errorf("Expected status 400 for a malformed date, got %d", status)
+	}
+}
+
+func TestCountriesEndpoint(t *testing.T) {
+	status, body := get(t, "/v1/countries")
+	if status != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", status)
+	}
+
+	found := false
+	for _, code := range body["countries"].([]interface{}) {
+		found = found || code == "US"
+	}
+	if !found {
+		t.Error("Expected US in the supported countries")
+	}
+}
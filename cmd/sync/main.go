@@ -5,16 +5,29 @@ import (
 	"encoding/json"
 	"flag"
 	"fmt"
+	"io"
 	"log"
 	"os"
+	"os/signal"
 	"path/filepath"
+	"sort"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/coredds/goholiday/config"
 	"github.com/coredds/goholiday/updater"
 )
 
+// countryDelay is the pause between countries when syncing all of them
+var countryDelay = 1 * time.Second
+
+// watchRetryDelay is the initial retry delay after a failed watch check
+var watchRetryDelay = 1 * time.Minute
+
+// syncNotifier, set with -notify, receives the result of each country synced
+var syncNotifier updater.Notifier
+
 func main() {
 	var (
 		country   = flag.String("country", "", "Specific country to sync (e.g., US, GB, CA, AU)")
@@ -26,11 +39,31 @@ func main() {
 		validate  = flag.Bool("validate", false, "Validate existing data against Python source")
 		force     = flag.Bool("force", false, "Force sync even if data appears up-to-date")
 		token     = flag.String("token", "", "GitHub Personal Access Token for authentication (optional)")
+		watch     = flag.Bool("watch", false, "Continuously check for upstream updates and sync when found")
+		interval  = flag.Duration("interval", 24*time.Hour, "Interval between update checks in watch mode")
+		preview   = flag.Bool("preview", false, "Show the holiday changes a sync would apply to the compiled provider (requires -country)")
+		year      = flag.Int("year", time.Now().Year(), "Sample year used by -preview")
+		emitTypes = flag.Bool("emit-types", false, "Write TypeScript and JSON Schema definitions for the synced JSON to the output directory")
+		notify    = flag.String("notify", "", "Send each country's sync result to \"stdout\" or POST it as JSON to a webhook URL")
+		workers   = flag.Int("concurrency", 0, "Countries fetched at once when syncing all of them (0 picks a default, higher with a token)")
 	)
 	flag.Parse()
 
-	fmt.Println("goholidays Python Sync Tool")
-	fmt.Println("===========================")
+	// Watch mode keeps stdout for its JSON events, so everything else goes to stderr
+	console := io.Writer(os.Stdout)
+	if *watch {
+		console = os.Stderr
+	}
+
+	fmt.Fprintln(console, "goholidays Python Sync Tool")
+	fmt.Fprintln(console, "===========================")
+
+	if *emitTypes {
+		if err := writeTypeDefinitions(*outputDir, os.Stdout); err != nil {
+			log.Fatalf("Failed to write type definitions: %v", err)
+		}
+		return
+	}
 
 	// Get GitHub token from flag, config file, or environment variable
 	githubToken := *token
@@ -43,14 +76,25 @@ func main() {
 	if githubToken != "" {
 		syncer = updater.NewGitHubSyncerWithToken(githubToken)
 		if *verbose {
-			fmt.Println("Using authenticated GitHub API access")
+			fmt.Fprintln(console, "Using authenticated GitHub API access")
 		}
 	} else {
 		syncer = updater.NewGitHubSyncer()
 		if *verbose {
-			fmt.Println("Using unauthenticated GitHub API access (rate limited)")
+			fmt.Fprintln(console, "Using unauthenticated GitHub API access (rate limited)")
 		}
 	}
+	if *notify != "" {
+		notifier, err := newNotifier(*notify)
+		if err != nil {
+			log.Fatalf("Invalid -notify: %v", err)
+		}
+		syncNotifier = notifier
+		if githubSyncer, ok := syncer.(*updater.GitHubSyncer); ok {
+			githubSyncer.SetNotifier(notifier)
+		}
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
 	defer cancel()
 
@@ -61,20 +105,42 @@ func main() {
 				log.Fatalf("GitHub token validation failed: %v", err)
 			}
 			if *verbose {
-				fmt.Println("✓ GitHub token validated successfully")
+				fmt.Fprintln(console, "✓ GitHub token validated successfully")
 			}
 		}
 	}
 
+	if *watch {
+		watchCtx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		checker := updater.NewPythonHolidaysSync(*outputDir)
+		if err := runWatch(watchCtx, checker, syncer, *outputDir, *interval, *timeout, os.Stderr, os.Stdout); err != nil {
+			log.Fatalf("Watch mode failed: %v", err)
+		}
+		return
+	}
+
 	if *listOnly {
-		if err := listCountries(ctx, syncer); err != nil {
+		if err := listCountries(ctx, syncer, os.Stdout); err != nil {
 			log.Fatalf("Failed to list countries: %v", err)
 		}
 		return
 	}
 
+	if *preview {
+		if *country == "" {
+			log.Fatal("-preview requires -country")
+		}
+		previewer := updater.NewPythonHolidaysSync(*outputDir)
+		if err := previewCountry(ctx, syncer, previewer, strings.ToUpper(*country), *year, os.Stdout); err != nil {
+			log.Fatalf("Failed to preview %s: %v", *country, err)
+		}
+		return
+	}
+
 	if *country != "" {
-		if err := syncSingleCountry(ctx, syncer, *country, *outputDir, *dryRun, *verbose); err != nil {
+		if err := syncSingleCountry(ctx, syncer, *country, *outputDir, *dryRun, *verbose, os.Stdout); err != nil {
 			log.Fatalf("Failed to sync %s: %v", *country, err)
 		}
 		return
@@ -88,36 +154,74 @@ func main() {
 	}
 
 	// Default: sync all countries
-	if err := syncAllCountries(ctx, syncer, *outputDir, *dryRun, *verbose, *force); err != nil {
+	if err := syncAllCountries(ctx, syncer, *outputDir, *dryRun, *verbose, *force, *workers, os.Stdout); err != nil {
 		log.Fatalf("Failed to sync: %v", err)
 	}
 }
 
-func listCountries(ctx context.Context, syncer updater.Syncer) error {
-	fmt.Println("Fetching available countries from Python holidays repository...")
+func listCountries(ctx context.Context, syncer updater.Syncer, out io.Writer) error {
+	fmt.Fprintln(out, "Fetching available countries from Python holidays repository...")
 
-	countries, err := syncer.FetchCountryList(ctx)
+	listing, err := syncer.FetchCountryListing(ctx)
 	if err != nil {
 		return err
 	}
 
-	fmt.Printf("\nFound %d countries:\n", len(countries))
-	fmt.Println("Code | Status")
-	fmt.Println("-----|-------")
+	fmt.Fprintf(out, "\nFound %d countries:\n", len(listing.Countries))
+	fmt.Fprintln(out, "Code | Status")
+	fmt.Fprintln(out, "-----|-------")
 
-	for _, country := range countries {
+	for _, country := range listing.Countries {
 		status := "Available"
-		fmt.Printf("%-4s | %s\n", country, status)
+		fmt.Fprintf(out, "%-4s | %s\n", country, status)
+	}
+
+	if len(listing.Unmapped) > 0 {
+		fmt.Fprintf(out, "\n%d files could not be mapped to a country code:\n", len(listing.Unmapped))
+		for _, filename := range listing.Unmapped {
+			fmt.Fprintf(out, "  - %s\n", filename)
+		}
 	}
 
 	return nil
 }
 
-func syncSingleCountry(ctx context.Context, syncer updater.Syncer, countryCode, outputDir string, dryRun, verbose bool) error {
-	fmt.Printf("Syncing country: %s\n", countryCode)
+// newNotifier creates the notifier named by -notify: "stdout" or a webhook URL
+func newNotifier(spec string) (updater.Notifier, error) {
+	switch {
+	case spec == "stdout":
+		return updater.NewStdoutNotifier(), nil
+	case strings.HasPrefix(spec, "http://") || strings.HasPrefix(spec, "https://"):
+		return updater.NewWebhookNotifier(spec), nil
+	default:
+		return nil, fmt.Errorf("expected \"stdout\" or a webhook URL, got %q", spec)
+	}
+}
+
+// notifySync passes the outcome of syncing a country to syncNotifier, if set
+func notifySync(ctx context.Context, result updater.SyncResult, err error) {
+	if syncNotifier == nil {
+		return
+	}
+
+	result.Duration = time.Since(result.StartedAt)
+	result.Success = err == nil
+	if err != nil {
+		result.Error = err.Error()
+	}
+	if notifyErr := syncNotifier.Notify(ctx, result); notifyErr != nil {
+		log.Printf("Warning: failed to send sync notification for %s: %v", result.CountryCode, notifyErr)
+	}
+}
+
+func syncSingleCountry(ctx context.Context, syncer updater.Syncer, countryCode, outputDir string, dryRun, verbose bool, out io.Writer) (err error) {
+	result := updater.SyncResult{CountryCode: countryCode, StartedAt: time.Now()}
+	defer func() { notifySync(ctx, result, err) }()
+
+	fmt.Fprintf(out, "Syncing country: %s\n", countryCode)
 
 	if dryRun {
-		fmt.Println("DRY RUN MODE - No files will be modified")
+		fmt.Fprintln(out, "DRY RUN MODE - No files will be modified")
 	}
 
 	// Create output directory
@@ -129,7 +233,7 @@ func syncSingleCountry(ctx context.Context, syncer updater.Syncer, countryCode,
 
 	// Fetch Python source
 	if verbose {
-		fmt.Printf("Fetching Python source for %s...\n", countryCode)
+		fmt.Fprintf(out, "Fetching Python source for %s...\n", countryCode)
 	}
 
 	pythonSource, err := syncer.FetchCountryFile(ctx, countryCode)
@@ -138,7 +242,7 @@ func syncSingleCountry(ctx context.Context, syncer updater.Syncer, countryCode,
 	}
 
 	if verbose {
-		fmt.Printf("Source length: %d characters\n", len(pythonSource))
+		fmt.Fprintf(out, "Source length: %d characters\n", len(pythonSource))
 	}
 
 	// Validate content
@@ -148,31 +252,32 @@ func syncSingleCountry(ctx context.Context, syncer updater.Syncer, countryCode,
 
 	// Parse holiday definitions
 	if verbose {
-		fmt.Println("Parsing holiday definitions...")
+		fmt.Fprintln(out, "Parsing holiday definitions...")
 	}
 
 	countryData, err := syncer.ParseHolidayDefinitions(pythonSource)
 	if err != nil {
 		return fmt.Errorf("failed to parse definitions: %w", err)
 	}
+	result.Holidays = len(countryData.Holidays)
 
 	// Display results
-	fmt.Printf("\nCountry: %s (%s)\n", countryData.Name, countryData.CountryCode)
-	fmt.Printf("Subdivisions: %d\n", len(countryData.Subdivisions))
-	fmt.Printf("Holidays: %d\n", len(countryData.Holidays))
-	fmt.Printf("Categories: %v\n", countryData.Categories)
-	fmt.Printf("Languages: %v\n", countryData.Languages)
+	fmt.Fprintf(out, "\nCountry: %s (%s)\n", countryData.Name, countryData.CountryCode)
+	fmt.Fprintf(out, "Subdivisions: %d\n", len(countryData.Subdivisions))
+	fmt.Fprintf(out, "Holidays: %d\n", len(countryData.Holidays))
+	fmt.Fprintf(out, "Categories: %v\n", countryData.Categories)
+	fmt.Fprintf(out, "Languages: %v\n", countryData.Languages)
 
 	if verbose {
-		fmt.Println("\nHolidays found:")
+		fmt.Fprintln(out, "\nHolidays found:")
 		for name, holiday := range countryData.Holidays {
-			fmt.Printf("  - %s: %s (%s)\n", name, holiday.Name, holiday.Calculation)
+			fmt.Fprintf(out, "  - %s: %s (%s)\n", name, holiday.Name, holiday.Calculation)
 		}
 
 		if len(countryData.Subdivisions) > 0 {
-			fmt.Println("\nSubdivisions:")
+			fmt.Fprintln(out, "\nSubdivisions:")
 			for code, name := range countryData.Subdivisions {
-				fmt.Printf("  - %s: %s\n", code, name)
+				fmt.Fprintf(out, "  - %s: %s\n", code, name)
 			}
 		}
 	}
@@ -189,17 +294,98 @@ func syncSingleCountry(ctx context.Context, syncer updater.Syncer, countryCode,
 		if err := saveCountryData(countryData, outputFile); err != nil {
 			return fmt.Errorf("failed to save data to %s: %w", outputFile, err)
 		}
-		fmt.Printf("Data saved to: %s\n", outputFile)
+		fmt.Fprintf(out, "Data saved to: %s\n", outputFile)
+	}
+
+	return nil
+}
+
+// writeTypeDefinitions writes TypeScript and JSON Schema definitions of the synced
+// country files to outputDir, for consumers of the JSON outside Go
+func writeTypeDefinitions(outputDir string, out io.Writer) error {
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+
+	schema, err := updater.JSONSchema()
+	if err != nil {
+		return fmt.Errorf("failed to generate JSON Schema: %w", err)
+	}
+
+	files := []struct {
+		name    string
+		content []byte
+	}{
+		{"country_data.d.ts", []byte(updater.TypeScriptDefinitions())},
+		{"country_data.schema.json", append(schema, '\n')},
+	}
+	for _, file := range files {
+		path := filepath.Join(outputDir, file.name)
+		if err := os.WriteFile(path, file.content, 0644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", path, err)
+		}
+		fmt.Fprintf(out, "Wrote %s\n", path)
 	}
 
 	return nil
 }
 
-func syncAllCountries(ctx context.Context, syncer updater.Syncer, outputDir string, dryRun, verbose, force bool) error {
-	fmt.Println("Syncing all available countries...")
+// changePreviewer diffs parsed data against a compiled provider
+type changePreviewer interface {
+	PreviewChanges(data *updater.CountryData, year int) ([]updater.HolidayChange, error)
+}
+
+// previewCountry parses the upstream source for a country and prints the holiday
+// changes a sync would apply relative to the compiled provider for year.
+func previewCountry(ctx context.Context, syncer updater.Syncer, previewer changePreviewer, countryCode string, year int, out io.Writer) error {
+	pythonSource, err := syncer.FetchCountryFile(ctx, countryCode)
+	if err != nil {
+		return fmt.Errorf("failed to fetch source: %w", err)
+	}
+
+	if err := syncer.ValidatePythonContent(pythonSource); err != nil {
+		return fmt.Errorf("invalid Python content: %w", err)
+	}
+
+	freshData, err := syncer.ParseHolidayDefinitions(pythonSource)
+	if err != nil {
+		return fmt.Errorf("failed to parse definitions: %w", err)
+	}
+	if freshData.CountryCode == "" {
+		freshData.CountryCode = countryCode
+	}
+
+	changes, err := previewer.PreviewChanges(freshData, year)
+	if err != nil {
+		return fmt.Errorf("failed to compute changes: %w", err)
+	}
+
+	fmt.Fprintf(out, "Preview for %s (%d):\n", countryCode, year)
+	if len(changes) == 0 {
+		fmt.Fprintln(out, "  No changes")
+		return nil
+	}
+	for _, change := range changes {
+		fmt.Fprintf(out, "  %s\n", change)
+	}
+	fmt.Fprintf(out, "%d changes\n", len(changes))
+	return nil
+}
+
+// concurrentSyncer fetches and parses every country at once, as updater.GitHubSyncer does
+type concurrentSyncer interface {
+	SyncAll(ctx context.Context, concurrency int) (map[string]*updater.CountryData, []error)
+}
+
+func syncAllCountries(ctx context.Context, syncer updater.Syncer, outputDir string, dryRun, verbose, force bool, concurrency int, out io.Writer) error {
+	fmt.Fprintln(out, "Syncing all available countries...")
 
 	if dryRun {
-		fmt.Println("DRY RUN MODE - No files will be modified")
+		fmt.Fprintln(out, "DRY RUN MODE - No files will be modified")
+	}
+
+	if concurrent, ok := syncer.(concurrentSyncer); ok {
+		return syncAllConcurrently(ctx, concurrent, outputDir, dryRun, concurrency, out)
 	}
 
 	// Get country list
@@ -208,7 +394,7 @@ func syncAllCountries(ctx context.Context, syncer updater.Syncer, outputDir stri
 		return err
 	}
 
-	fmt.Printf("Found %d countries to sync\n", len(countries))
+	fmt.Fprintf(out, "Found %d countries to sync\n", len(countries))
 
 	// Create output directory
 	if !dryRun {
@@ -221,27 +407,151 @@ func syncAllCountries(ctx context.Context, syncer updater.Syncer, outputDir stri
 	failed := 0
 
 	for i, country := range countries {
-		fmt.Printf("\n[%d/%d] Syncing %s...", i+1, len(countries), country)
+		fmt.Fprintf(out, "\n[%d/%d] Syncing %s...", i+1, len(countries), country)
 
-		if err := syncSingleCountry(ctx, syncer, country, outputDir, dryRun, verbose); err != nil {
-			fmt.Printf(" FAILED: %v\n", err)
+		if err := syncSingleCountry(ctx, syncer, country, outputDir, dryRun, verbose, out); err != nil {
+			fmt.Fprintf(out, " FAILED: %v\n", err)
 			failed++
 			continue
 		}
 
-		fmt.Printf(" SUCCESS\n")
+		fmt.Fprintf(out, " SUCCESS\n")
 		successful++
 
 		// Rate limiting between countries
 		if i < len(countries)-1 {
-			time.Sleep(1 * time.Second)
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(countryDelay):
+			}
 		}
 	}
 
-	fmt.Printf("\nSync completed: %d successful, %d failed\n", successful, failed)
+	fmt.Fprintf(out, "\nSync completed: %d successful, %d failed\n", successful, failed)
 	return nil
 }
 
+// syncAllConcurrently syncs every country through the syncer's worker pool, then saves
+// the countries that succeeded
+func syncAllConcurrently(ctx context.Context, syncer concurrentSyncer, outputDir string, dryRun bool, concurrency int, out io.Writer) error {
+	results, errs := syncer.SyncAll(ctx, concurrency)
+	if results == nil && len(errs) > 0 {
+		return errs[0]
+	}
+
+	if !dryRun {
+		if err := os.MkdirAll(outputDir, 0755); err != nil {
+			return fmt.Errorf("failed to create output directory: %w", err)
+		}
+	}
+
+	codes := make([]string, 0, len(results))
+	for code := range results {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	successful := 0
+	for _, code := range codes {
+		if !dryRun {
+			outputFile := filepath.Join(outputDir, fmt.Sprintf("%s.json", strings.ToUpper(code)))
+			if err := saveCountryData(results[code], outputFile); err != nil {
+				errs = append(errs, fmt.Errorf("%s: failed to save data to %s: %w", code, outputFile, err))
+				continue
+			}
+		}
+		fmt.Fprintf(out, "Synced %s: %d holidays\n", code, len(results[code].Holidays))
+		successful++
+	}
+	for _, err := range errs {
+		fmt.Fprintf(out, "FAILED %v\n", err)
+	}
+
+	fmt.Fprintf(out, "\nSync completed: %d successful, %d failed\n", successful, len(errs))
+	return nil
+}
+
+// updateChecker reports whether upstream holiday data has changed since the last sync
+type updateChecker interface {
+	CheckForUpdates() (bool, error)
+}
+
+// watchEvent is a structured record of a single watch-mode check
+type watchEvent struct {
+	Time             time.Time `json:"time"`
+	UpdatesAvailable bool      `json:"updates_available"`
+	Synced           bool      `json:"synced"`
+	Error            string    `json:"error,omitempty"`
+}
+
+// runWatch checks for updates every interval until ctx is cancelled, writing one
+// JSON event per check to events and nothing else, so they can be piped to another
+// program; status messages go to status and sync output is discarded. After a failed check it retries sooner, doubling
+// the delay on each consecutive failure up to the regular interval.
+func runWatch(ctx context.Context, checker updateChecker, syncer updater.Syncer, outputDir string, interval, timeout time.Duration, status, events io.Writer) error {
+	if interval <= 0 {
+		return fmt.Errorf("watch interval must be positive, got %v", interval)
+	}
+
+	fmt.Fprintf(status, "Watching for updates every %v (Ctrl+C to stop)\n", interval)
+
+	encoder := json.NewEncoder(events)
+	failures := 0
+
+	for {
+		tickCtx, cancel := context.WithTimeout(ctx, timeout)
+		event := watchTick(tickCtx, checker, syncer, outputDir)
+		cancel()
+
+		if err := encoder.Encode(event); err != nil {
+			return fmt.Errorf("failed to write watch event: %w", err)
+		}
+
+		delay := interval
+		if event.Error != "" {
+			failures++
+			delay = watchRetryDelay << (failures - 1)
+			if delay <= 0 || delay > interval {
+				delay = interval
+			}
+		} else {
+			failures = 0
+		}
+
+		select {
+		case <-ctx.Done():
+			fmt.Fprintln(status, "Watch mode stopped")
+			return nil
+		case <-time.After(delay):
+		}
+	}
+}
+
+// watchTick checks for upstream updates once and syncs all countries when any are found
+func watchTick(ctx context.Context, checker updateChecker, syncer updater.Syncer, outputDir string) watchEvent {
+	event := watchEvent{Time: time.Now()}
+
+	hasUpdates, err := checker.CheckForUpdates()
+	if err != nil {
+		event.Error = fmt.Sprintf("update check failed: %v", err)
+		return event
+	}
+
+	event.UpdatesAvailable = hasUpdates
+	if !hasUpdates {
+		return event
+	}
+
+	if err := syncAllCountries(ctx, syncer, outputDir, false, false, false, 0, io.Discard); err != nil {
+		event.Error = fmt.Sprintf("sync failed: %v", err)
+		return event
+	}
+
+	event.Synced = true
+	return event
+}
+
 func validateData(ctx context.Context, syncer updater.Syncer, dataDir string, verbose bool) error {
 	fmt.Println("Validating existing data against Python source...")
 
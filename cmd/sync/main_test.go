@@ -1,9 +1,14 @@
 package main
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"io"
 	"os"
 	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -21,7 +26,7 @@ func TestSyncFunctionality(t *testing.T) {
 	// Test sync with no existing data
 	t.Run("Initial Sync", func(t *testing.T) {
 		syncer := updater.NewMockSyncer()
-		err := syncSingleCountry(context.Background(), syncer, "US", tempDir, false, false)
+		err := syncSingleCountry(context.Background(), syncer, "US", tempDir, false, false, io.Discard)
 		if err != nil {
 			t.Errorf("Initial sync failed: %v", err)
 		}
@@ -40,7 +45,7 @@ func TestSyncFunctionality(t *testing.T) {
 	// Test sync with existing data
 	t.Run("Update Sync", func(t *testing.T) {
 		syncer := updater.NewMockSyncer()
-		err := syncSingleCountry(context.Background(), syncer, "US", tempDir, false, false)
+		err := syncSingleCountry(context.Background(), syncer, "US", tempDir, false, false, io.Discard)
 		if err != nil {
 			t.Errorf("Update sync failed: %v", err)
 		}
@@ -59,7 +64,7 @@ func TestSyncFunctionality(t *testing.T) {
 	// Test sync specific country
 	t.Run("Single Country Sync", func(t *testing.T) {
 		syncer := updater.NewMockSyncer()
-		err := syncSingleCountry(context.Background(), syncer, "US", tempDir, false, false)
+		err := syncSingleCountry(context.Background(), syncer, "US", tempDir, false, false, io.Discard)
 		if err != nil {
 			t.Errorf("Single country sync failed: %v", err)
 		}
@@ -78,7 +83,7 @@ func TestErrorHandling(t *testing.T) {
 		syncer := updater.NewMockSyncer()
 		// Use a path with invalid characters that can't be created
 		invalidPath := "invalid\x00path\x00with\x00nulls"
-		err := syncSingleCountry(context.Background(), syncer, "US", invalidPath, false, false)
+		err := syncSingleCountry(context.Background(), syncer, "US", invalidPath, false, false, io.Discard)
 		if err == nil {
 			t.Error("Should show error for invalid directory")
 		}
@@ -93,7 +98,7 @@ func TestErrorHandling(t *testing.T) {
 		defer os.RemoveAll(tempDir)
 
 		syncer := updater.NewMockSyncer()
-		err = syncSingleCountry(context.Background(), syncer, "XX", tempDir, false, false)
+		err = syncSingleCountry(context.Background(), syncer, "XX", tempDir, false, false, io.Discard)
 		if err == nil {
 			t.Error("Should show error for invalid country code")
 		}
@@ -123,7 +128,7 @@ func TestErrorHandling(t *testing.T) {
 		}
 
 		syncer := updater.NewMockSyncer()
-		err = syncSingleCountry(context.Background(), syncer, "US", tempDir, false, false)
+		err = syncSingleCountry(context.Background(), syncer, "US", tempDir, false, false, io.Discard)
 		if err == nil {
 			t.Error("Should show error for unwritable directory")
 		}
@@ -147,7 +152,7 @@ func TestConcurrentSync(t *testing.T) {
 		for i := 0; i < 3; i++ {
 			go func() {
 				syncer := updater.NewMockSyncer()
-				err := syncSingleCountry(context.Background(), syncer, "US", tempDir, false, false)
+				err := syncSingleCountry(context.Background(), syncer, "US", tempDir, false, false, io.Discard)
 				if err != nil {
 					t.Errorf("Concurrent sync failed: %v", err)
 				}
@@ -187,7 +192,7 @@ func TestPerformance(t *testing.T) {
 	t.Run("Sync Performance", func(t *testing.T) {
 		start := time.Now()
 		syncer := updater.NewMockSyncer()
-		err := syncSingleCountry(context.Background(), syncer, "US", tempDir, false, false)
+		err := syncSingleCountry(context.Background(), syncer, "US", tempDir, false, false, io.Discard)
 		if err != nil {
 			t.Errorf("Sync failed: %v", err)
 		}
@@ -203,7 +208,7 @@ func TestPerformance(t *testing.T) {
 	t.Run("Incremental Sync Performance", func(t *testing.T) {
 		start := time.Now()
 		syncer := updater.NewMockSyncer()
-		err := syncSingleCountry(context.Background(), syncer, "US", tempDir, false, false)
+		err := syncSingleCountry(context.Background(), syncer, "US", tempDir, false, false, io.Discard)
 		if err != nil {
 			t.Errorf("Incremental sync failed: %v", err)
 		}
@@ -215,3 +220,233 @@ func TestPerformance(t *testing.T) {
 		}
 	})
 }
+
+// lockedBuffer is a bytes.Buffer safe for concurrent writes and reads
+type lockedBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *lockedBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *lockedBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestWatchMode(t *testing.T) {
+	countryDelay = 0
+	defer func() { countryDelay = 1 * time.Second }()
+
+	tempDir, err := os.MkdirTemp("", "goholidays-sync-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp directory: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	t.Run("Tick With Updates", func(t *testing.T) {
+		syncer := updater.NewMockSyncer()
+		syncer.SetUpdatesAvailable(true)
+
+		event := watchTick(context.Background(), syncer, syncer, tempDir)
+		if event.Error != "" {
+			t.Fatalf("Unexpected watch error: %s", event.Error)
+		}
+		if !event.UpdatesAvailable || !event.Synced {
+			t.Errorf("Expected updates to be synced, got %+v", event)
+		}
+
+		if _, err := os.Stat(filepath.Join(tempDir, "US.json")); os.IsNotExist(err) {
+			t.Error("Country file should exist after watch sync")
+		}
+	})
+
+	t.Run("Tick Without Updates", func(t *testing.T) {
+		syncer := updater.NewMockSyncer()
+
+		event := watchTick(context.Background(), syncer, syncer, tempDir)
+		if event.UpdatesAvailable || event.Synced {
+			t.Errorf("Expected no sync without updates, got %+v", event)
+		}
+	})
+
+	t.Run("Sync Stops On Cancel", func(t *testing.T) {
+		countryDelay = time.Hour
+		defer func() { countryDelay = 0 }()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+
+		done := make(chan error)
+		go func() {
+			done <- syncAllCountries(ctx, updater.NewMockSyncer(), tempDir, true, false, false, 0, io.Discard)
+		}()
+
+		select {
+		case err := <-done:
+			if err == nil {
+				t.Error("Expected the cancelled sync to return an error")
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("Sync did not stop waiting between countries after cancellation")
+		}
+	})
+
+	t.Run("Stops On Cancel", func(t *testing.T) {
+		syncer := updater.NewMockSyncer()
+		syncer.SetUpdatesAvailable(true)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		var status, events lockedBuffer
+		done := make(chan error)
+		go func() {
+			done <- runWatch(ctx, syncer, syncer, tempDir, time.Hour, time.Minute, &status, &events)
+		}()
+
+		// Let the first tick complete before cancelling
+		deadline := time.Now().Add(5 * time.Second)
+		for !strings.Contains(events.String(), "updates_available") && time.Now().Before(deadline) {
+			time.Sleep(10 * time.Millisecond)
+		}
+		cancel()
+
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Errorf("Watch returned error: %v", err)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("Watch did not stop after cancellation")
+		}
+
+		// The events stream holds only the JSON event, with status and sync output kept out
+		var event watchEvent
+		if err := json.Unmarshal([]byte(events.String()), &event); err != nil {
+			t.Fatalf("Failed to decode watch event from %q: %v", events.String(), err)
+		}
+		if !event.Synced {
+			t.Errorf("Expected first tick to sync, got %+v", event)
+		}
+		if !strings.Contains(status.String(), "Watching for updates") || !strings.Contains(status.String(), "Watch mode stopped") {
+			t.Errorf("Expected watch status messages, got %q", status.String())
+		}
+		if strings.Contains(status.String(), "Syncing") {
+			t.Errorf("Expected sync output to be silenced in watch mode, got %q", status.String())
+		}
+	})
+}
+
+func TestPreviewCountry(t *testing.T) {
+	syncer := updater.NewMockSyncer()
+	previewer := updater.NewMockPythonHolidaysSync(t.TempDir())
+
+	var out bytes.Buffer
+	if err := previewCountry(context.Background(), syncer, previewer, "US", 2024, &out); err != nil {
+		t.Fatalf("previewCountry() failed: %v", err)
+	}
+
+	output := out.String()
+	if !strings.Contains(output, "Preview for US (2024)") {
+		t.Errorf("Expected preview header, got %q", output)
+	}
+	// The mock parser only knows New Year's Day, so the rest would be removed
+	if !strings.Contains(output, "- Independence Day (2024-07-04)") {
+		t.Errorf("Expected Independence Day removal, got %q", output)
+	}
+
+	if err := previewCountry(context.Background(), syncer, previewer, "XX", 2024, &out); err == nil {
+		t.Error("Expected error for unknown country")
+	}
+}
+
+func TestListCountries(t *testing.T) {
+	syncer := updater.NewMockSyncer()
+	syncer.AddUnmappedFile("atlantis.py")
+
+	var out bytes.Buffer
+	if err := listCountries(context.Background(), syncer, &out); err != nil {
+		t.Fatalf("listCountries() failed: %v", err)
+	}
+
+	output := out.String()
+	if !strings.Contains(output, "US   | Available") {
+		t.Errorf("Expected US to be listed as available, got %q", output)
+	}
+	if !strings.Contains(output, "1 files could not be mapped") || !strings.Contains(output, "- atlantis.py") {
+		t.Errorf("Expected unmapped files to be listed, got %q", output)
+	}
+}
+
+func TestWriteTypeDefinitions(t *testing.T) {
+	outputDir := filepath.Join(t.TempDir(), "types")
+
+	var out bytes.Buffer
+	if err := writeTypeDefinitions(outputDir, &out); err != nil {
+		t.Fatalf("writeTypeDefinitions() failed: %v", err)
+	}
+
+	definitions, err := os.ReadFile(filepath.Join(outputDir, "country_data.d.ts"))
+	if err != nil {
+		t.Fatalf("Expected TypeScript definitions to be written: %v", err)
+	}
+	if !strings.Contains(string(definitions), "export interface CountryData {") {
+		t.Errorf("Unexpected TypeScript definitions:\n%s", definitions)
+	}
+
+	schema, err := os.ReadFile(filepath.Join(outputDir, "country_data.schema.json"))
+	if err != nil {
+		t.Fatalf("Expected JSON Schema to be written: %v", err)
+	}
+	var parsed map[string]interface{}
+	if err := json.Unmarshal(schema, &parsed); err != nil {
+		t.Errorf("JSON Schema is not valid JSON: %v", err)
+	}
+
+	if !strings.Contains(out.String(), "country_data.schema.json") {
+		t.Errorf("Expected written files to be reported, got %q", out.String())
+	}
+}
+
+// recordingNotifier collects the sync results it receives
+type recordingNotifier struct {
+	results []updater.SyncResult
+}
+
+func (n *recordingNotifier) Notify(ctx context.Context, result updater.SyncResult) error {
+	n.results = append(n.results, result)
+	return nil
+}
+
+func TestSyncNotifications(t *testing.T) {
+	for _, spec := range []string{"stdout", "https://example.com/hook"} {
+		if _, err := newNotifier(spec); err != nil {
+			t.Errorf("newNotifier(%q) failed: %v", spec, err)
+		}
+	}
+	if _, err := newNotifier("email"); err == nil {
+		t.Error("Expected an error for an unknown notifier")
+	}
+
+	notifier := &recordingNotifier{}
+	syncNotifier = notifier
+	defer func() { syncNotifier = nil }()
+
+	syncer := updater.NewMockSyncer()
+	_ = syncSingleCountry(context.Background(), syncer, "US", t.TempDir(), true, false, io.Discard)
+	_ = syncSingleCountry(context.Background(), syncer, "XX", t.TempDir(), true, false, io.Discard)
+
+	if len(notifier.results) != 2 {
+		t.Fatalf("Expected 2 notifications, got %d", len(notifier.results))
+	}
+	if result := notifier.results[0]; !result.Success || result.CountryCode != "US" || result.Holidays == 0 {
+		t.Errorf("Expected a successful US result with holidays, got %+v", result)
+	}
+	if result := notifier.results[1]; result.Success || result.Error == "" {
+		t.Errorf("Expected a failed XX result, got %+v", result)
+	}
+}
@@ -0,0 +1,67 @@
+package goholidays
+
+import "time"
+
+// Combine creates a virtual Country whose holidays are the union of those of countries,
+// e.g. a calendar that is off whenever either the US or the UK office is closed. Each
+// holiday is tagged with the codes of the countries it comes from in Sources; a holiday
+// of the same name on the same date in several countries appears once, listing all of
+// them. The combined Country reports name as its code and can be passed anywhere a
+// Country is used, such as NewBusinessDayCalculator. Each year is read from the
+// countries when first used, so later changes to them, such as custom holidays, only
+// reach years the combined calendar has not loaded yet.
+func Combine(name string, countries ...*Country) *Country {
+	c := NewCountry(name)
+	// The countries have already filtered their holidays by category
+	c.includeOptional = true
+	c.sources = countries
+	return c
+}
+
+// loadCombinedHolidays loads the union of the source countries' holidays for a year
+// (caller must hold the write lock)
+func (c *Country) loadCombinedHolidays(year int) {
+	for _, source := range c.sources {
+		for _, holiday := range source.SortedHolidaysForYear(year) {
+			for _, onDate := range source.HolidaysOn(holiday.Date) {
+				c.addSourceHoliday(year, onDate, source.code)
+			}
+		}
+	}
+}
+
+// addSourceHoliday adds a copy of a source country's holiday tagged with its origin, or
+// adds the origin to an already loaded holiday of the same name and date
+func (c *Country) addSourceHoliday(year int, holiday *Holiday, code string) {
+	sources := holiday.Sources
+	if len(sources) == 0 {
+		sources = []string{code}
+	}
+
+	date := time.Date(holiday.Date.Year(), holiday.Date.Month(), holiday.Date.Day(), 0, 0, 0, 0, time.UTC)
+	loaded := append([]*Holiday{c.years[year][date]}, c.extras[year][date]...)
+	for _, existing := range loaded {
+		if existing != nil && existing.Name == holiday.Name {
+			existing.Sources = appendMissing(existing.Sources, sources...)
+			return
+		}
+	}
+
+	tagged := *holiday
+	tagged.Sources = append([]string(nil), sources...)
+	c.addHoliday(year, &tagged)
+}
+
+// appendMissing appends the values not already in list
+func appendMissing(list []string, values ...string) []string {
+	for _, value := range values {
+		found := false
+		for _, existing := range list {
+			found = found || existing == value
+		}
+		if !found {
+			list = append(list, value)
+		}
+	}
+	return list
+}
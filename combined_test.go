@@ -0,0 +1,98 @@
+package goholidays
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCombine(t *testing.T) {
+	us := NewCountry("US")
+	gb := NewCountry("GB")
+	combined := Combine("US+GB", us, gb)
+
+	if combined.GetCountryCode() != "US+GB" {
+		t.Errorf("Expected code US+GB, got %s", combined.GetCountryCode())
+	}
+
+	tests := []struct {
+		date    time.Time
+		name    string
+		sources []string
+	}{
+		{time.Date(2024, 7, 4, 0, 0, 0, 0, time.UTC), "Independence Day", []string{"US"}},
+		{time.Date(2024, 8, 26, 0, 0, 0, 0, time.UTC), "Summer Bank Holiday", []string{"GB"}},
+		{time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC), "Christmas Day", []string{"US", "GB"}},
+	}
+	for _, tt := range tests {
+		holiday, isHoliday := combined.IsHoliday(tt.date)
+		if !isHoliday {
+			t.Errorf("Expected %s on %s", tt.name, tt.date.Format("2006-01-02"))
+			continue
+		}
+		if holiday.Name != tt.name || !reflect.DeepEqual(holiday.Sources, tt.sources) {
+			t.Errorf("Expected %s from %v on %s, got %s from %v",
+				tt.name, tt.sources, tt.date.Format("2006-01-02"), holiday.Name, holiday.Sources)
+		}
+	}
+
+	// Tagging copies the holidays, leaving the source countries untouched
+	if holiday, _ := us.IsHoliday(time.Date(2024, 7, 4, 0, 0, 0, 0, time.UTC)); len(holiday.Sources) != 0 {
+		t.Errorf("Expected the US holiday to carry no sources, got %v", holiday.Sources)
+	}
+
+	// The year holds the union by date
+	union := make(map[time.Time]bool)
+	for date := range us.HolidaysForYear(2024) {
+		union[date] = true
+	}
+	for date := range gb.HolidaysForYear(2024) {
+		union[date] = true
+	}
+	if holidays := combined.HolidaysForYear(2024); len(holidays) != len(union) {
+		t.Errorf("Expected %d holidays in the union, got %d", len(union), len(holidays))
+	}
+
+	// Business days are closed on either country's holidays
+	calc := NewBusinessDayCalculator(combined)
+	for _, date := range []time.Time{
+		time.Date(2024, 7, 4, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 8, 26, 0, 0, 0, 0, time.UTC),
+	} {
+		if calc.IsBusinessDay(date) {
+			t.Errorf("Expected %s not to be a business day", date.Format("2006-01-02"))
+		}
+	}
+}
+
+func TestCombineWithContext(t *testing.T) {
+	combined := Combine("US+GB", NewCountry("US"), NewCountry("GB"))
+	ctx := context.Background()
+
+	holiday, isHoliday, err := combined.IsHolidayWithContext(ctx, time.Date(2024, 8, 26, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("IsHolidayWithContext failed: %v", err)
+	}
+	if !isHoliday || holiday.Name != "Summer Bank Holiday" {
+		t.Errorf("Expected Summer Bank Holiday, got %v", holiday)
+	}
+
+	holidays, err := combined.HolidaysForYearWithContext(ctx, 2025)
+	if err != nil {
+		t.Fatalf("HolidaysForYearWithContext failed: %v", err)
+	}
+	expected := Combine("US+GB", NewCountry("US"), NewCountry("GB")).HolidaysForYear(2025)
+	if len(holidays) != len(expected) {
+		t.Errorf("Expected the context path to load the same %d holidays, got %d", len(expected), len(holidays))
+	}
+}
+
+func TestCombineNested(t *testing.T) {
+	combined := Combine("ALL", Combine("NA", NewCountry("US"), NewCountry("CA")), NewCountry("GB"))
+
+	holiday, isHoliday := combined.IsHoliday(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	if !isHoliday || !reflect.DeepEqual(holiday.Sources, []string{"US", "CA", "GB"}) {
+		t.Errorf("Expected New Year's Day from US, CA and GB, got %v", holiday)
+	}
+}
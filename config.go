@@ -0,0 +1,109 @@
+package goholidays
+
+import "github.com/coredds/goholiday/config"
+
+// NewCountryFromConfig creates a Country for code that follows the configuration held by
+// cm. The country's configured subdivisions and categories, the default language and, when
+// caching is enabled, max_cache_size as the number of years kept loaded set up the Country. As each year is loaded, holidays named in excluded_holidays are dropped,
+// holidays named in overrides are renamed, and the custom holidays configured for the
+// country (or for every country, under "*") are added; a custom holiday limited to
+// subdivisions is only added when the Country includes one of them. The configuration is
+// read from cm when a year is loaded, so years loaded after cm is reloaded follow the new
+// configuration. It returns an error if code is not supported or is disabled in the
+// configuration.
+func NewCountryFromConfig(cm *config.ConfigManager, code string) (*Country, error) {
+	if err := ValidateCountryCode(code); err != nil {
+		return nil, err
+	}
+	if !cm.IsCountryEnabled(code) {
+		return nil, NewCountryError(ErrInvalidCountry, code, "country is disabled in the configuration")
+	}
+
+	countryConfig := cm.GetCountryConfig(code)
+	options := CountryOptions{Subdivisions: countryConfig.Subdivisions}
+	for _, category := range countryConfig.Categories {
+		options.Categories = append(options.Categories, HolidayCategory(category))
+	}
+	if cfg := cm.GetConfig(); cfg != nil {
+		options.Language = cfg.General.DefaultLanguage
+		if cfg.Performance.EnableCaching && cfg.Performance.MaxCacheSize > 0 {
+			options.MaxCachedYears = cfg.Performance.MaxCacheSize
+		}
+	}
+
+	c := NewCountry(code, options)
+	c.configManager = cm
+	return c, nil
+}
+
+// applyConfigHolidays applies the exclusions, name overrides and custom holidays of a
+// Country made by NewCountryFromConfig to a year being loaded (caller must hold the write lock)
+func (c *Country) applyConfigHolidays(year int) {
+	if c.configManager == nil {
+		return
+	}
+	countryConfig := c.configManager.GetCountryConfig(c.code)
+
+	if len(countryConfig.ExcludedHolidays) > 0 {
+		excluded := make(map[string]bool, len(countryConfig.ExcludedHolidays))
+		for _, name := range countryConfig.ExcludedHolidays {
+			excluded[name] = true
+		}
+		c.filterHolidays(year, func(holiday *Holiday) bool {
+			return !excluded[holiday.Name]
+		})
+	}
+
+	for date, holiday := range c.years[year] {
+		if name, exists := countryConfig.Overrides[holiday.Name]; exists {
+			c.years[year][date] = renamedHoliday(holiday, name)
+		}
+	}
+	for _, extras := range c.extras[year] {
+		for i, holiday := range extras {
+			if name, exists := countryConfig.Overrides[holiday.Name]; exists {
+				extras[i] = renamedHoliday(holiday, name)
+			}
+		}
+	}
+
+	for _, custom := range c.configManager.CustomHolidaysForYear(c.code, year) {
+		if len(custom.Subdivisions) > 0 && !c.hasAnySubdivision(custom.Subdivisions) {
+			continue
+		}
+		holiday := fromProviderHoliday(*custom)
+		if holiday.Category == "" {
+			holiday.Category = CategoryPublic
+		}
+		c.addHoliday(year, holiday)
+	}
+}
+
+// hasAnySubdivision reports whether the Country includes any of the subdivisions
+func (c *Country) hasAnySubdivision(subdivisions []string) bool {
+	for _, subdivision := range subdivisions {
+		for _, included := range c.subdivisions {
+			if included == subdivision {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// renamedHoliday returns a copy of holiday called name, also replacing the localized
+// names that matched its old name
+func renamedHoliday(holiday *Holiday, name string) *Holiday {
+	renamed := *holiday
+	renamed.Name = name
+	if holiday.Languages != nil {
+		renamed.Languages = make(map[string]string, len(holiday.Languages))
+		for lang, localName := range holiday.Languages {
+			if localName == holiday.Name {
+				localName = name
+			}
+			renamed.Languages[lang] = localName
+		}
+	}
+	return &renamed
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
+	"strconv"
 	"strings"
 	"time"
 
@@ -60,8 +62,17 @@ type CustomHoliday struct {
 	Languages    map[string]string `yaml:"languages"`
 	YearRange    *YearRange        `yaml:"year_range,omitempty"`
 	Calculation  *CalculationRule  `yaml:"calculation,omitempty"`
+	LeapDay      string            `yaml:"leap_day,omitempty"` // Policy for "02-29" in non-leap years
 }
 
+// Leap day policies for custom holidays dated February 29. In non-leap years the
+// holiday is skipped (the default), or observed on February 28 or March 1 instead.
+const (
+	LeapDaySkip  = "skip"
+	LeapDayFeb28 = "feb28"
+	LeapDayMar1  = "mar1"
+)
+
 // YearRange defines when a holiday is valid
 type YearRange struct {
 	Start int `yaml:"start,omitempty"`
@@ -103,6 +114,47 @@ type PerformanceConfig struct {
 	BatchSize       int           `yaml:"batch_size"`       // Batch size for bulk operations
 }
 
+// UnmarshalYAML decodes performance settings, accepting cache_ttl either as a
+// duration string ("24h", "168h") or as a bare number of seconds
+func (pc *PerformanceConfig) UnmarshalYAML(value *yaml.Node) error {
+	type plainPerformanceConfig PerformanceConfig
+
+	var cacheTTL *time.Duration
+	node := *value
+	if value.Kind == yaml.MappingNode {
+		node.Content = nil
+		for i := 0; i+1 < len(value.Content); i += 2 {
+			key, val := value.Content[i], value.Content[i+1]
+			if key.Value == "cache_ttl" {
+				ttl, err := parseDurationValue(val.Value)
+				if err != nil {
+					return fmt.Errorf("line %d: invalid cache_ttl %q: %w", val.Line, val.Value, err)
+				}
+				cacheTTL = &ttl
+				continue
+			}
+			node.Content = append(node.Content, key, val)
+		}
+	}
+
+	if err := node.Decode((*plainPerformanceConfig)(pc)); err != nil {
+		return err
+	}
+
+	if cacheTTL != nil {
+		pc.CacheTTL = *cacheTTL
+	}
+	return nil
+}
+
+// parseDurationValue parses a Go duration string, treating bare integers as seconds
+func parseDurationValue(value string) (time.Duration, error) {
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second, nil
+	}
+	return time.ParseDuration(value)
+}
+
 // LoggingConfig controls logging behavior
 type LoggingConfig struct {
 	Level      string `yaml:"level"`  // "debug", "info", "warn", "error"
@@ -112,6 +164,56 @@ type LoggingConfig struct {
 	MaxSize    int    `yaml:"max_size"` // Max log file size in MB
 }
 
+// Merge returns a new configuration with override layered over c. In the General,
+// Output, Performance and Logging sections every non-zero override field wins, and
+// slices such as SupportedLanguages replace the base ones rather than extend them.
+// Countries and CustomHolidays are merged key by key, an override entry replacing the
+// base entry for the same country. Since zero values are ignored, an override cannot
+// switch a boolean setting off. Neither c nor override is modified.
+func (c *Config) Merge(override *Config) *Config {
+	merged := *c
+
+	mergeFields(&merged.General, &override.General)
+	mergeFields(&merged.Output, &override.Output)
+	mergeFields(&merged.Performance, &override.Performance)
+	mergeFields(&merged.Logging, &override.Logging)
+
+	merged.Countries = make(map[string]CountryConfig, len(c.Countries)+len(override.Countries))
+	for code, country := range c.Countries {
+		merged.Countries[code] = country
+	}
+	for code, country := range override.Countries {
+		merged.Countries[code] = country
+	}
+
+	merged.CustomHolidays = make(map[string][]CustomHoliday, len(c.CustomHolidays)+len(override.CustomHolidays))
+	for code, holidays := range c.CustomHolidays {
+		merged.CustomHolidays[code] = holidays
+	}
+	for code, holidays := range override.CustomHolidays {
+		merged.CustomHolidays[code] = holidays
+	}
+
+	return &merged
+}
+
+// mergeFields sets every non-zero field of the struct override points to on the struct
+// merged points to, copying slices so the result does not share them with override
+func mergeFields(merged, override interface{}) {
+	dst := reflect.ValueOf(merged).Elem()
+	src := reflect.ValueOf(override).Elem()
+	for i := 0; i < src.NumField(); i++ {
+		field := src.Field(i)
+		if field.IsZero() {
+			continue
+		}
+		if field.Kind() == reflect.Slice {
+			field = reflect.AppendSlice(reflect.MakeSlice(field.Type(), 0, field.Len()), field)
+		}
+		dst.Field(i).Set(field)
+	}
+}
+
 // ConfigManager handles configuration loading and management
 type ConfigManager struct {
 	config *Config
@@ -340,6 +442,18 @@ func (cm *ConfigManager) validateConfig(config *Config) error {
 			config.General.Environment, validEnvs)
 	}
 
+	// Validate leap day policies
+	for country, holidays := range config.CustomHolidays {
+		for _, holiday := range holidays {
+			switch holiday.LeapDay {
+			case "", LeapDaySkip, LeapDayFeb28, LeapDayMar1:
+			default:
+				return fmt.Errorf("invalid leap_day policy %q for custom holiday %s in %s (must be one of: %v)",
+					holiday.LeapDay, holiday.Name, country, []string{LeapDaySkip, LeapDayFeb28, LeapDayMar1})
+			}
+		}
+	}
+
 	// Validate logging level
 	validLevels := []string{"debug", "info", "warn", "error"}
 	valid = false
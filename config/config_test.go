@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/coredds/goholiday/countries"
+	"gopkg.in/yaml.v3"
 )
 
 func TestBasicConfigurationLoading(t *testing.T) {
@@ -253,6 +254,100 @@ logging:
 	}
 }
 
+func TestConfigMerge(t *testing.T) {
+	baseContent := `
+general:
+  default_country: US
+  default_language: en
+  supported_languages: [en, es, fr]
+  environment: dev
+countries:
+  US:
+    enabled: true
+    subdivisions: [CA]
+  GB:
+    enabled: true
+custom_holidays:
+  US:
+    - name: Founders Day
+      date: "2024-03-15"
+performance:
+  enable_caching: true
+  cache_ttl: 24h
+  max_cache_size: 500
+logging:
+  level: debug
+  format: text
+`
+	prodContent := `
+general:
+  supported_languages: [en]
+  environment: prod
+countries:
+  US:
+    enabled: true
+    subdivisions: [NY, TX]
+  CA:
+    enabled: true
+custom_holidays:
+  CA:
+    - name: Company Day
+      date: "2024-08-01"
+performance:
+  cache_ttl: 168h
+logging:
+  level: warn
+  output: /var/log/goholidays.log
+`
+
+	var base, prod Config
+	if err := yaml.Unmarshal([]byte(baseContent), &base); err != nil {
+		t.Fatalf("Failed to parse base config: %v", err)
+	}
+	if err := yaml.Unmarshal([]byte(prodContent), &prod); err != nil {
+		t.Fatalf("Failed to parse prod config: %v", err)
+	}
+
+	merged := base.Merge(&prod)
+
+	// Non-zero override fields win; zero ones keep the base value
+	if merged.General.DefaultCountry != "US" || merged.General.DefaultLanguage != "en" {
+		t.Errorf("Expected base general settings to be kept, got %+v", merged.General)
+	}
+	if merged.General.Environment != "prod" {
+		t.Errorf("Expected environment prod, got %q", merged.General.Environment)
+	}
+	if len(merged.General.SupportedLanguages) != 1 || merged.General.SupportedLanguages[0] != "en" {
+		t.Errorf("Expected supported languages to be replaced by [en], got %v", merged.General.SupportedLanguages)
+	}
+	if !merged.Performance.EnableCaching || merged.Performance.MaxCacheSize != 500 || merged.Performance.CacheTTL != 168*time.Hour {
+		t.Errorf("Unexpected performance settings %+v", merged.Performance)
+	}
+	if merged.Logging.Level != "warn" || merged.Logging.Format != "text" || merged.Logging.Output != "/var/log/goholidays.log" {
+		t.Errorf("Unexpected logging settings %+v", merged.Logging)
+	}
+
+	// Country maps merge key by key
+	if subdivisions := merged.Countries["US"].Subdivisions; len(subdivisions) != 2 || subdivisions[0] != "NY" {
+		t.Errorf("Expected US subdivisions from prod, got %v", subdivisions)
+	}
+	if !merged.Countries["GB"].Enabled || !merged.Countries["CA"].Enabled {
+		t.Errorf("Expected GB from base and CA from prod, got %v", merged.Countries)
+	}
+	if len(merged.CustomHolidays["US"]) != 1 || len(merged.CustomHolidays["CA"]) != 1 {
+		t.Errorf("Expected custom holidays for US and CA, got %v", merged.CustomHolidays)
+	}
+
+	// Neither input is modified
+	if base.General.Environment != "dev" || len(base.Countries) != 2 || len(base.General.SupportedLanguages) != 3 {
+		t.Error("Merge modified the base config")
+	}
+	merged.General.SupportedLanguages[0] = "xx"
+	if prod.General.SupportedLanguages[0] != "en" {
+		t.Error("Merged config shares slices with the override")
+	}
+}
+
 // TestConfigValidation tests configuration validation
 func TestConfigValidation(t *testing.T) {
 	cm := NewConfigManager()
@@ -435,6 +530,55 @@ func TestDefaultConfig(t *testing.T) {
 	}
 }
 
+// TestPerformanceDurationRoundTrip tests that cache_ttl survives a YAML round trip
+func TestPerformanceDurationRoundTrip(t *testing.T) {
+	testCases := []struct {
+		yaml     string
+		expected time.Duration
+	}{
+		{"performance:\n  cache_ttl: 48h\n", 48 * time.Hour},
+		{"performance:\n  cache_ttl: \"168h\"\n", 168 * time.Hour},
+		{"performance:\n  cache_ttl: 3600\n", time.Hour},
+	}
+
+	for _, tc := range testCases {
+		cm := NewConfigManager()
+		config := cm.getDefaultConfig()
+		if err := yaml.Unmarshal([]byte(tc.yaml), config); err != nil {
+			t.Fatalf("Failed to unmarshal %q: %v", tc.yaml, err)
+		}
+
+		if config.Performance.CacheTTL != tc.expected {
+			t.Errorf("%q: expected CacheTTL %v, got %v", tc.yaml, tc.expected, config.Performance.CacheTTL)
+		}
+
+		// Settings outside cache_ttl should keep their defaults
+		if config.Performance.MaxCacheSize != 1000 {
+			t.Errorf("%q: expected default MaxCacheSize 1000, got %d", tc.yaml, config.Performance.MaxCacheSize)
+		}
+
+		// Marshal and unmarshal again
+		data, err := yaml.Marshal(config)
+		if err != nil {
+			t.Fatalf("Failed to marshal config: %v", err)
+		}
+
+		var roundTripped Config
+		if err := yaml.Unmarshal(data, &roundTripped); err != nil {
+			t.Fatalf("Failed to unmarshal marshaled config: %v", err)
+		}
+
+		if roundTripped.Performance.CacheTTL != tc.expected {
+			t.Errorf("%q: expected round-tripped CacheTTL %v, got %v", tc.yaml, tc.expected, roundTripped.Performance.CacheTTL)
+		}
+	}
+
+	var config Config
+	if err := yaml.Unmarshal([]byte("performance:\n  cache_ttl: soon\n"), &config); err == nil {
+		t.Error("Invalid cache_ttl should produce an error")
+	}
+}
+
 // BenchmarkConfigLoading benchmarks configuration loading
 func BenchmarkConfigLoading(b *testing.B) {
 	configContent := `
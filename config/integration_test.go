@@ -416,3 +416,66 @@ performance:
 		os.Unsetenv("GOHOLIDAYS_ENV")
 	}
 }
+
+func TestLeapDayCustomHoliday(t *testing.T) {
+	hm := NewHolidayManager()
+
+	tests := []struct {
+		policy   string
+		year     int
+		expected time.Time
+		ok       bool
+	}{
+		{LeapDaySkip, 2024, time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), true},
+		{LeapDaySkip, 2023, time.Time{}, false},
+		{"", 2023, time.Time{}, false},
+		{LeapDayFeb28, 2023, time.Date(2023, 2, 28, 0, 0, 0, 0, time.UTC), true},
+		{LeapDayMar1, 2023, time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC), true},
+		{LeapDayMar1, 2024, time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), true},
+		{LeapDayFeb28, 1900, time.Date(1900, 2, 28, 0, 0, 0, 0, time.UTC), true},
+	}
+
+	for _, tt := range tests {
+		custom := CustomHoliday{Name: "Leap Day", Date: "02-29", LeapDay: tt.policy}
+		date, err := hm.calculateCustomHolidayDate(custom, tt.year)
+		if tt.ok {
+			if err != nil {
+				t.Errorf("policy %q, year %d: unexpected error %v", tt.policy, tt.year, err)
+			} else if !date.Equal(tt.expected) {
+				t.Errorf("policy %q, year %d: expected %s, got %s", tt.policy, tt.year, tt.expected.Format("2006-01-02"), date.Format("2006-01-02"))
+			}
+		} else if err == nil {
+			t.Errorf("policy %q, year %d: expected the holiday to be skipped", tt.policy, tt.year)
+		}
+	}
+
+	// Skipped leap day holidays are left out of the year rather than failing it
+	hm.configManager.config = &Config{
+		CustomHolidays: map[string][]CustomHoliday{
+			"US": {{Name: "Leap Day Social", Date: "02-29", Category: "company"}},
+		},
+	}
+	if holidays := hm.getCustomHolidays("US", 2023, hm.configManager.config); len(holidays) != 0 {
+		t.Errorf("Expected no custom holidays in 2023, got %d", len(holidays))
+	}
+	if holidays := hm.getCustomHolidays("US", 2024, hm.configManager.config); len(holidays) != 1 {
+		t.Errorf("Expected the leap day holiday in 2024, got %d", len(holidays))
+	}
+}
+
+func TestLeapDayPolicyValidation(t *testing.T) {
+	cm := NewConfigManager()
+	config := cm.getDefaultConfig()
+	config.CustomHolidays = map[string][]CustomHoliday{
+		"US": {{Name: "Leap Day", Date: "02-29", LeapDay: "feb30"}},
+	}
+
+	if err := cm.validateConfig(config); err == nil {
+		t.Error("Expected an error for an unknown leap_day policy")
+	}
+
+	config.CustomHolidays["US"][0].LeapDay = LeapDayMar1
+	if err := cm.validateConfig(config); err != nil {
+		t.Errorf("Unexpected error for a valid leap_day policy: %v", err)
+	}
+}
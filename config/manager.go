@@ -160,12 +160,22 @@ func (hm *HolidayManager) applyCountryConfig(holidays map[time.Time]*countries.H
 // getCustomHolidays processes custom holidays from configuration
 func (hm *HolidayManager) getCustomHolidays(countryCode string, year int, config *Config) map[time.Time]*countries.Holiday {
 	holidays := make(map[time.Time]*countries.Holiday)
-	customHolidays := hm.configManager.GetCustomHolidays(countryCode)
+	for _, holiday := range hm.configManager.CustomHolidaysForYear(countryCode, year) {
+		holidays[holiday.Date] = holiday
+	}
+	return holidays
+}
+
+// CustomHolidaysForYear returns the custom holidays configured for a country that fall in
+// year, in configuration order. Holidays outside their year range or whose date cannot be
+// calculated are skipped.
+func (cm *ConfigManager) CustomHolidaysForYear(countryCode string, year int) []*countries.Holiday {
+	var holidays []*countries.Holiday
 
 	// Use a map to track unique holidays by date+name combination for deduplication
 	seen := make(map[string]bool)
 
-	for _, custom := range customHolidays {
+	for _, custom := range cm.GetCustomHolidays(countryCode) {
 		// Check year range
 		if custom.YearRange != nil {
 			if custom.YearRange.Start > 0 && year < custom.YearRange.Start {
@@ -177,7 +187,7 @@ func (hm *HolidayManager) getCustomHolidays(countryCode string, year int, config
 		}
 
 		// Calculate the date
-		date, err := hm.calculateCustomHolidayDate(custom, year)
+		date, err := customHolidayDate(custom, year)
 		if err != nil {
 			continue // Skip invalid dates
 		}
@@ -189,27 +199,50 @@ func (hm *HolidayManager) getCustomHolidays(countryCode string, year int, config
 		}
 		seen[uniqueKey] = true
 
-		// Create the holiday
-		holiday := &countries.Holiday{
-			Name:      custom.Name,
-			Date:      date,
-			Category:  custom.Category,
-			Languages: custom.Languages,
-		}
-
-		holidays[date] = holiday
+		holidays = append(holidays, &countries.Holiday{
+			Name:         custom.Name,
+			Date:         date,
+			Category:     custom.Category,
+			Languages:    custom.Languages,
+			Subdivisions: custom.Subdivisions,
+		})
 	}
 
 	return holidays
 }
 
+// isLeapYear reports whether February has 29 days in the given year
+func isLeapYear(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+// leapDayFallback applies a custom holiday's leap day policy in a non-leap year
+func leapDayFallback(custom CustomHoliday, year int) (time.Time, error) {
+	switch custom.LeapDay {
+	case LeapDayFeb28:
+		return time.Date(year, 2, 28, 0, 0, 0, 0, time.UTC), nil
+	case LeapDayMar1:
+		return time.Date(year, 3, 1, 0, 0, 0, 0, time.UTC), nil
+	default:
+		return time.Time{}, fmt.Errorf("custom holiday %s falls on February 29, which does not exist in %d", custom.Name, year)
+	}
+}
+
 // calculateCustomHolidayDate calculates the date for a custom holiday
 func (hm *HolidayManager) calculateCustomHolidayDate(custom CustomHoliday, year int) (time.Time, error) {
+	return customHolidayDate(custom, year)
+}
+
+// customHolidayDate calculates the date of a custom holiday in year
+func customHolidayDate(custom CustomHoliday, year int) (time.Time, error) {
 	if custom.Date != "" {
 		// Fixed date - parse YYYY-MM-DD or MM-DD
 		if strings.Contains(custom.Date, fmt.Sprintf("%d-", year)) {
 			return time.Parse("2006-01-02", custom.Date)
 		} else if len(custom.Date) == 5 { // MM-DD format
+			if custom.Date == "02-29" && !isLeapYear(year) {
+				return leapDayFallback(custom, year)
+			}
 			return time.Parse("2006-01-02", fmt.Sprintf("%d-%s", year, custom.Date))
 		}
 	}
@@ -0,0 +1,103 @@
+package goholidays
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/coredds/goholiday/config"
+)
+
+func TestNewCountryFromConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "goholidays.yaml")
+	yaml := `
+general:
+  environment: prod
+countries:
+  US:
+    enabled: true
+    overrides:
+      Independence Day: Fourth of July
+    excluded_holidays:
+      - Columbus Day
+  CA:
+    enabled: false
+custom_holidays:
+  US:
+    - name: Founders' Day
+      date: "03-15"
+      category: company
+    - name: Gold Rush Day
+      date: "01-24"
+      subdivisions: [CA]
+`
+	if err := os.WriteFile(path, []byte(yaml), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cm := config.NewConfigManager()
+	if _, err := cm.LoadConfigFromFile(path); err != nil {
+		t.Fatalf("LoadConfigFromFile failed: %v", err)
+	}
+
+	us, err := NewCountryFromConfig(cm, "US")
+	if err != nil {
+		t.Fatalf("NewCountryFromConfig failed: %v", err)
+	}
+
+	if _, isHoliday := us.IsHoliday(time.Date(2024, 10, 14, 0, 0, 0, 0, time.UTC)); isHoliday {
+		t.Error("Expected the excluded Columbus Day to be dropped")
+	}
+
+	holiday, isHoliday := us.IsHoliday(time.Date(2024, 7, 4, 0, 0, 0, 0, time.UTC))
+	if !isHoliday || holiday.Name != "Fourth of July" {
+		t.Errorf("Expected Independence Day renamed to Fourth of July, got %v", holiday)
+	} else if holiday.Languages["en"] != "Fourth of July" || holiday.Languages["es"] != "Día de la Independencia" {
+		t.Errorf("Expected only the English name replaced, got %v", holiday.Languages)
+	}
+	if NewCountry("US").HolidaysForYear(2024)[time.Date(2024, 7, 4, 0, 0, 0, 0, time.UTC)].Name != "Independence Day" {
+		t.Error("Expected the override to leave other countries unchanged")
+	}
+
+	holiday, isHoliday = us.IsHoliday(time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC))
+	if !isHoliday || holiday.Name != "Founders' Day" || holiday.Category != "company" {
+		t.Errorf("Expected the custom Founders' Day, got %v", holiday)
+	}
+	if _, isHoliday := us.IsHoliday(time.Date(2024, 1, 24, 0, 0, 0, 0, time.UTC)); isHoliday {
+		t.Error("Expected no California holiday without the CA subdivision")
+	}
+
+	if _, err := NewCountryFromConfig(cm, "CA"); err == nil {
+		t.Error("Expected an error for a disabled country")
+	}
+	if _, err := NewCountryFromConfig(cm, "XX"); err == nil {
+		t.Error("Expected an error for an unsupported country")
+	}
+}
+
+func TestNewCountryFromConfigCacheSize(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "goholidays.yaml")
+	yaml := `
+performance:
+  enable_caching: true
+  max_cache_size: 2
+`
+	if err := os.WriteFile(path, []byte(yaml), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cm := config.NewConfigManager()
+	if _, err := cm.LoadConfigFromFile(path); err != nil {
+		t.Fatalf("LoadConfigFromFile failed: %v", err)
+	}
+
+	us, err := NewCountryFromConfig(cm, "US")
+	if err != nil {
+		t.Fatalf("NewCountryFromConfig failed: %v", err)
+	}
+	for year := 2024; year <= 2026; year++ {
+		us.HolidaysForYear(year)
+	}
+	if stats := us.CacheStats(); stats.Years != 2 || stats.Evictions != 1 {
+		t.Errorf("Expected max_cache_size to keep 2 years loaded, got %+v", stats)
+	}
+}
@@ -141,17 +141,6 @@ func (at *ATProvider) LoadHolidays(year int) map[time.Time]*Holiday {
 	// Easter-based holidays
 	easter := EasterSunday(year)
 
-	// Easter Sunday
-	holidays[easter] = at.CreateHoliday(
-		"Ostersonntag",
-		easter,
-		"religious",
-		map[string]string{
-			"de": "Ostersonntag",
-			"en": "Easter Sunday",
-		},
-	)
-
 	// Easter Monday
 	easterMonday := easter.AddDate(0, 0, 1)
 	holidays[easterMonday] = at.CreateHoliday(
@@ -416,3 +405,8 @@ func (at *ATProvider) GetSpecialObservances(year int) map[time.Time]*Holiday {
 
 	return holidays
 }
+
+// LoadHolidaysForSubdivisions returns the national holidays plus those of the given states
+func (at *ATProvider) LoadHolidaysForSubdivisions(year int, states []string) map[time.Time]*Holiday {
+	return mergeHolidays(at.LoadHolidays(year), at.GetRegionalHolidays(year, states))
+}
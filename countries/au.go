@@ -148,6 +148,11 @@ func (au *AUProvider) LoadHolidays(year int) map[time.Time]*Holiday {
 	return holidays
 }
 
+// ExpectedHolidayCount returns a rough lower bound on the number of national holidays in a year
+func (au *AUProvider) ExpectedHolidayCount(year int) int {
+	return 10
+}
+
 // GetStateHolidays returns state-specific holidays
 func (au *AUProvider) GetStateHolidays(year int, states []string) map[time.Time]*Holiday {
 	holidays := make(map[time.Time]*Holiday)
@@ -322,3 +327,8 @@ func (au *AUProvider) GetSeasons(year int) map[string][]time.Time {
 		},
 	}
 }
+
+// LoadHolidaysForSubdivisions returns the national holidays plus those of the given states
+func (au *AUProvider) LoadHolidaysForSubdivisions(year int, states []string) map[time.Time]*Holiday {
+	return mergeHolidays(au.LoadHolidays(year), au.GetStateHolidays(year, states))
+}
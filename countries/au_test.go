@@ -292,3 +292,11 @@ func BenchmarkAUProvider_StateHolidays(b *testing.B) {
 		_ = provider.GetStateHolidays(year, states)
 	}
 }
+
+func TestAUProvider_ExpectedHolidayCount(t *testing.T) {
+	provider := NewAUProvider()
+
+	for _, year := range []int{2000, 2020, 2024} {
+		AssertMinHolidays(t, provider, year, provider.ExpectedHolidayCount(year))
+	}
+}
@@ -2,15 +2,21 @@
 package countries
 
 import (
+	"sort"
 	"time"
 )
 
+// DefaultLanguage is the language every holiday is named in, and the one displays fall
+// back to when a holiday has no name in the requested language
+const DefaultLanguage = "en"
+
 // HolidayProvider defines the interface for country-specific holiday providers
 type HolidayProvider interface {
 	LoadHolidays(year int) map[time.Time]*Holiday
 	GetCountryCode() string
 	GetSupportedSubdivisions() []string
 	GetSupportedCategories() []string
+	Capabilities() ProviderCapabilities
 }
 
 // Holiday represents a holiday with all its properties
@@ -22,6 +28,46 @@ type Holiday struct {
 	Languages    map[string]string `json:"languages,omitempty"`
 	IsObserved   bool              `json:"is_observed"`
 	Subdivisions []string          `json:"subdivisions,omitempty"`
+
+	// SubstituteFor names the holiday a substitute day replaces; HasSubstitute marks
+	// the original holiday, whose Observed date then points at the substitute day
+	SubstituteFor string `json:"substitute_for,omitempty"`
+	HasSubstitute bool   `json:"has_substitute,omitempty"`
+
+	// FromYear and ToYear are the first and last years the holiday is observed, such as
+	// 1983 for Martin Luther King Jr. Day; zero leaves that end open
+	FromYear int `json:"from_year,omitempty"`
+	ToYear   int `json:"to_year,omitempty"`
+}
+
+// InEffect reports whether the holiday is observed in year, according to FromYear and ToYear
+func (h *Holiday) InEffect(year int) bool {
+	return (h.FromYear == 0 || year >= h.FromYear) && (h.ToYear == 0 || year <= h.ToYear)
+}
+
+// inEffect removes the holidays not observed in year from holidays and returns it
+func inEffect(holidays map[time.Time]*Holiday, year int) map[time.Time]*Holiday {
+	for date, holiday := range holidays {
+		if !holiday.InEffect(year) {
+			delete(holidays, date)
+		}
+	}
+	return holidays
+}
+
+// MissingLanguage returns the holidays whose Languages lack a name in language, ordered
+// by date
+func MissingLanguage(holidays map[time.Time]*Holiday, language string) []*Holiday {
+	var missing []*Holiday
+	for _, holiday := range holidays {
+		if holiday.Languages[language] == "" {
+			missing = append(missing, holiday)
+		}
+	}
+	sort.Slice(missing, func(i, j int) bool {
+		return missing[i].Date.Before(missing[j].Date)
+	})
+	return missing
 }
 
 // BaseProvider provides common functionality for holiday providers
@@ -30,6 +76,12 @@ type BaseProvider struct {
 	subdivisions  []string
 	categories    []string
 	observedShift bool
+	// easterSunday is set by providers whose country observes Easter Sunday itself as a
+	// holiday; many only observe Good Friday and/or Easter Monday
+	easterSunday bool
+	// hijriOffset shifts the computed dates of Islamic holidays by whole days, for
+	// countries whose announced dates differ from the astronomical calendar
+	hijriOffset int
 }
 
 // NewBaseProvider creates a new base provider
@@ -57,6 +109,11 @@ func (bp *BaseProvider) GetSupportedCategories() []string {
 	return bp.categories
 }
 
+// ObservesEasterSunday reports whether the provider adds Easter Sunday as a holiday
+func (bp *BaseProvider) ObservesEasterSunday() bool {
+	return bp.easterSunday
+}
+
 // CalculateObservedDate calculates the observed date for a holiday
 func (bp *BaseProvider) CalculateObservedDate(date time.Time) *time.Time {
 	if !bp.observedShift {
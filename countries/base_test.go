@@ -0,0 +1,161 @@
+package countries
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestEasterSundayObservance(t *testing.T) {
+	type easterProvider interface {
+		HolidayProvider
+		ObservesEasterSunday() bool
+	}
+
+	tests := []struct {
+		provider easterProvider
+		observed bool
+	}{
+		{NewATProvider(), false},
+		{NewDEProvider(), false},
+		{NewFRProvider(), false},
+		{NewINProvider(), false},
+		{NewBEProvider(), true},
+		{NewFIProvider(), true},
+		{NewNLProvider(), true},
+		{NewNOProvider(), true},
+		{NewPLProvider(), true},
+		{NewPTProvider(), true},
+		{NewSEProvider(), true},
+	}
+
+	for _, tt := range tests {
+		code := tt.provider.GetCountryCode()
+		t.Run(code, func(t *testing.T) {
+			if got := tt.provider.ObservesEasterSunday(); got != tt.observed {
+				t.Errorf("ObservesEasterSunday() = %v, expected %v", got, tt.observed)
+			}
+
+			for _, year := range []int{2024, 2025} {
+				easter := EasterSunday(year)
+				_, exists := tt.provider.LoadHolidays(year)[easter]
+				if exists != tt.observed {
+					t.Errorf("%d: Easter Sunday (%s) present = %v, expected %v",
+						year, easter.Format("2006-01-02"), exists, tt.observed)
+				}
+			}
+		})
+	}
+}
+
+// untranslatedProvider emits a holiday named only in Spanish, lacking DefaultLanguage
+type untranslatedProvider struct {
+	*BaseProvider
+}
+
+func (p untranslatedProvider) LoadHolidays(year int) map[time.Time]*Holiday {
+	holidays := make(map[time.Time]*Holiday)
+	for _, holiday := range []*Holiday{
+		p.CreateHoliday("New Year's Day", time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC), "public",
+			map[string]string{"en": "New Year's Day", "es": "Año Nuevo"}),
+		p.CreateHoliday("Día de la Independencia", time.Date(year, 7, 9, 0, 0, 0, 0, time.UTC), "public",
+			map[string]string{"es": "Día de la Independencia"}),
+	} {
+		holidays[holiday.Date] = holiday
+	}
+	return holidays
+}
+
+// recordingTB records the failures reported through it instead of failing the test
+type recordingTB struct {
+	testing.TB
+	failures []string
+}
+
+func (r *recordingTB) Helper() {}
+
+func (r *recordingTB) Errorf(format string, args ...interface{}) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+func TestMissingLanguage(t *testing.T) {
+	provider := untranslatedProvider{NewBaseProvider("ZZ")}
+
+	missing := MissingLanguage(provider.LoadHolidays(2024), DefaultLanguage)
+	if len(missing) != 1 || missing[0].Name != "Día de la Independencia" {
+		t.Fatalf("Expected only Día de la Independencia to lack an English name, got %v", missing)
+	}
+
+	recorder := &recordingTB{TB: t}
+	AssertLanguage(recorder, provider, 2024, DefaultLanguage)
+	if len(recorder.failures) != 1 {
+		t.Errorf("Expected AssertLanguage to report 1 holiday, got %v", recorder.failures)
+	}
+}
+
+func TestProvidersHaveDefaultLanguage(t *testing.T) {
+	providers := []HolidayProvider{
+		NewARProvider(), NewATProvider(), NewAUProvider(), NewBEProvider(), NewBRProvider(),
+		NewCAProvider(), NewCHProvider(), NewCLProvider(), NewCNProvider(), NewDEProvider(),
+		NewESProvider(), NewFIProvider(), NewFRProvider(), NewGBProvider(), NewIDProvider(),
+		NewIEProvider(), NewILProvider(), NewINProvider(), NewITProvider(), NewJPProvider(),
+		NewKRProvider(), NewMXProvider(), NewNLProvider(), NewNOProvider(), NewNZProvider(),
+		NewPLProvider(), NewPTProvider(), NewRUProvider(), NewSEProvider(), NewSGProvider(),
+		NewTHProvider(), NewTRProvider(), NewUAProvider(), NewUSProvider(),
+	}
+
+	for _, provider := range providers {
+		for _, year := range []int{2000, 2024} {
+			AssertLanguage(t, provider, year, DefaultLanguage)
+		}
+	}
+}
+
+func TestHolidayInEffect(t *testing.T) {
+	tests := []struct {
+		from, to, year int
+		expected       bool
+	}{
+		{0, 0, 1900, true},
+		{1983, 0, 1982, false},
+		{1983, 0, 1983, true},
+		{0, 1999, 1999, true},
+		{0, 1999, 2000, false},
+		{2000, 2010, 2005, true},
+	}
+	for _, tt := range tests {
+		holiday := &Holiday{FromYear: tt.from, ToYear: tt.to}
+		if got := holiday.InEffect(tt.year); got != tt.expected {
+			t.Errorf("InEffect(%d) with range %d-%d = %v, expected %v", tt.year, tt.from, tt.to, got, tt.expected)
+		}
+	}
+
+	// Providers omit holidays before their first year and report it on later ones
+	starts := []struct {
+		provider HolidayProvider
+		name     string
+		fromYear int
+	}{
+		{NewUSProvider(), "Martin Luther King Jr. Day", 1983},
+		{NewUSProvider(), "Juneteenth", 2021},
+		{NewNZProvider(), "Matariki", 2022},
+		{NewINProvider(), "Republic Day", 1950},
+		{NewGBProvider(), "Early May Bank Holiday", 1978},
+	}
+	for _, start := range starts {
+		find := func(year int) *Holiday {
+			for _, holiday := range start.provider.LoadHolidays(year) {
+				if holiday.Name == start.name {
+					return holiday
+				}
+			}
+			return nil
+		}
+		if holiday := find(start.fromYear - 1); holiday != nil {
+			t.Errorf("%s: expected no %s in %d", start.provider.GetCountryCode(), start.name, start.fromYear-1)
+		}
+		if holiday := find(start.fromYear); holiday == nil || holiday.FromYear != start.fromYear {
+			t.Errorf("%s: expected %s from %d, got %+v", start.provider.GetCountryCode(), start.name, start.fromYear, holiday)
+		}
+	}
+}
@@ -17,6 +17,7 @@ func NewBEProvider() *BEProvider {
 		// Brussels-Capital Region, Flemish Region, Walloon Region
 	}
 	base.categories = []string{"public", "religious", "regional"}
+	base.easterSunday = true
 
 	return &BEProvider{BaseProvider: base}
 }
@@ -129,17 +130,19 @@ func (be *BEProvider) LoadHolidays(year int) map[time.Time]*Holiday {
 	easter := EasterSunday(year)
 
 	// Easter Sunday
-	holidays[easter] = be.CreateHoliday(
-		"Pasen",
-		easter,
-		"religious",
-		map[string]string{
-			"nl": "Pasen",
-			"fr": "Pâques",
-			"de": "Ostern",
-			"en": "Easter Sunday",
-		},
-	)
+	if be.ObservesEasterSunday() {
+		holidays[easter] = be.CreateHoliday(
+			"Pasen",
+			easter,
+			"religious",
+			map[string]string{
+				"nl": "Pasen",
+				"fr": "Pâques",
+				"de": "Ostern",
+				"en": "Easter Sunday",
+			},
+		)
+	}
 
 	// Easter Monday
 	easterMonday := easter.AddDate(0, 0, 1)
@@ -300,3 +303,8 @@ func (be *BEProvider) GetSpecialObservances(year int) map[time.Time]*Holiday {
 
 	return holidays
 }
+
+// LoadHolidaysForSubdivisions returns the national holidays plus those of the given regions
+func (be *BEProvider) LoadHolidaysForSubdivisions(year int, regions []string) map[time.Time]*Holiday {
+	return mergeHolidays(be.LoadHolidays(year), be.GetRegionalHolidays(year, regions))
+}
@@ -181,6 +181,14 @@ func (ca *CAProvider) LoadHolidays(year int) map[time.Time]*Holiday {
 	return holidays
 }
 
+// ExpectedHolidayCount returns a rough lower bound on the number of national holidays in a year
+func (ca *CAProvider) ExpectedHolidayCount(year int) int {
+	if year >= 2021 {
+		return 12
+	}
+	return 11
+}
+
 // getVictoriaDay calculates Victoria Day (Monday before May 25)
 func (ca *CAProvider) getVictoriaDay(year int) time.Time {
 	may25 := time.Date(year, 5, 25, 0, 0, 0, 0, time.UTC)
@@ -278,3 +286,8 @@ func (ca *CAProvider) getObservedDate(date time.Time) time.Time {
 		return date
 	}
 }
+
+// LoadHolidaysForSubdivisions returns the national holidays plus those of the given provinces
+func (ca *CAProvider) LoadHolidaysForSubdivisions(year int, provinces []string) map[time.Time]*Holiday {
+	return mergeHolidays(ca.LoadHolidays(year), ca.GetProvincialHolidays(year, provinces))
+}
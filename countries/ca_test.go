@@ -197,3 +197,11 @@ func BenchmarkCAProvincialHolidays(b *testing.B) {
 		ca.GetProvincialHolidays(2024, provinces)
 	}
 }
+
+func TestCAProvider_ExpectedHolidayCount(t *testing.T) {
+	provider := NewCAProvider()
+
+	for _, year := range []int{2000, 2020, 2024} {
+		AssertMinHolidays(t, provider, year, provider.ExpectedHolidayCount(year))
+	}
+}
@@ -0,0 +1,33 @@
+package countries
+
+// ProviderCapabilities describes which features a holiday provider supports, so callers
+// can check before relying on one
+type ProviderCapabilities struct {
+	Subdivisions     bool // Lists subdivisions with their own holidays
+	ObservedDates    bool // Sets observed dates for holidays falling on a weekend
+	HistoricalRanges bool // Adds or retires holidays according to the year they took effect
+	LunarCalendar    bool // Computes some holidays from a lunar or lunisolar calendar
+	EasterSunday     bool // Observes Easter Sunday itself as a holiday
+}
+
+// historicalProviders lists the countries whose providers apply year-dependent rules
+var historicalProviders = map[string]bool{
+	"AT": true, "AU": true, "BE": true, "CA": true, "CL": true, "DE": true, "IE": true,
+	"JP": true, "NL": true, "PL": true, "RU": true, "TR": true, "UA": true, "US": true,
+}
+
+// lunarProviders lists the countries whose providers include lunar calendar holidays
+var lunarProviders = map[string]bool{
+	"CN": true, "ID": true, "IL": true, "IN": true, "KR": true, "SG": true, "TH": true, "TR": true,
+}
+
+// Capabilities returns the features supported by the provider
+func (bp *BaseProvider) Capabilities() ProviderCapabilities {
+	return ProviderCapabilities{
+		Subdivisions:     len(bp.subdivisions) > 0,
+		ObservedDates:    bp.observedShift,
+		HistoricalRanges: historicalProviders[bp.countryCode],
+		LunarCalendar:    lunarProviders[bp.countryCode],
+		EasterSunday:     bp.easterSunday,
+	}
+}
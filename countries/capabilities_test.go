@@ -0,0 +1,43 @@
+package countries
+
+import "testing"
+
+func TestProviderCapabilities(t *testing.T) {
+	tests := []struct {
+		provider HolidayProvider
+		expected ProviderCapabilities
+	}{
+		{NewUSProvider(), ProviderCapabilities{Subdivisions: true, ObservedDates: true, HistoricalRanges: true}},
+		{NewJPProvider(), ProviderCapabilities{HistoricalRanges: true}},
+		{NewCNProvider(), ProviderCapabilities{Subdivisions: true, ObservedDates: true, LunarCalendar: true}},
+		{NewNLProvider(), ProviderCapabilities{Subdivisions: true, ObservedDates: true, HistoricalRanges: true, EasterSunday: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.provider.GetCountryCode(), func(t *testing.T) {
+			if got := tt.provider.Capabilities(); got != tt.expected {
+				t.Errorf("Capabilities() = %+v, expected %+v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestProviderCapabilitiesMatchSubdivisions(t *testing.T) {
+	providers := []HolidayProvider{
+		NewARProvider(), NewATProvider(), NewAUProvider(), NewBEProvider(), NewBRProvider(),
+		NewCAProvider(), NewCHProvider(), NewCLProvider(), NewCNProvider(), NewDEProvider(),
+		NewESProvider(), NewFIProvider(), NewFRProvider(), NewGBProvider(), NewIDProvider(),
+		NewIEProvider(), NewILProvider(), NewINProvider(), NewITProvider(), NewJPProvider(),
+		NewKRProvider(), NewMXProvider(), NewNLProvider(), NewNOProvider(), NewNZProvider(),
+		NewPLProvider(), NewPTProvider(), NewRUProvider(), NewSEProvider(), NewSGProvider(),
+		NewTHProvider(), NewTRProvider(), NewUAProvider(), NewUSProvider(),
+	}
+
+	for _, provider := range providers {
+		hasSubdivisions := len(provider.GetSupportedSubdivisions()) > 0
+		if got := provider.Capabilities().Subdivisions; got != hasSubdivisions {
+			t.Errorf("%s: Capabilities().Subdivisions = %v, but provider lists %d subdivisions",
+				provider.GetCountryCode(), got, len(provider.GetSupportedSubdivisions()))
+		}
+	}
+}
@@ -231,35 +231,10 @@ func (cn *CNProvider) getEnglishNumber(num int) string {
 // getSpringFestivalDates returns the dates for Spring Festival (Chinese New Year)
 // including Eve and the following days (total 7 days)
 func (cn *CNProvider) getSpringFestivalDates(year int) []time.Time {
-	var dates []time.Time
-	var eve time.Time
-
-	// Lookup table for Spring Festival Eve (Chinese New Year's Eve)
-	switch year {
-	case 2023:
-		eve = time.Date(2023, 1, 21, 0, 0, 0, 0, time.UTC)
-	case 2024:
-		eve = time.Date(2024, 2, 9, 0, 0, 0, 0, time.UTC)
-	case 2025:
-		eve = time.Date(2025, 1, 28, 0, 0, 0, 0, time.UTC)
-	case 2026:
-		eve = time.Date(2026, 2, 16, 0, 0, 0, 0, time.UTC)
-	case 2027:
-		eve = time.Date(2027, 2, 5, 0, 0, 0, 0, time.UTC)
-	case 2028:
-		eve = time.Date(2028, 1, 25, 0, 0, 0, 0, time.UTC)
-	case 2029:
-		eve = time.Date(2029, 2, 12, 0, 0, 0, 0, time.UTC)
-	case 2030:
-		eve = time.Date(2030, 2, 2, 0, 0, 0, 0, time.UTC)
-	default:
-		// Fallback to an approximation for years not in the lookup table
-		// This is just a rough estimate and should be updated with actual dates
-		eve = time.Date(year, 2, 1, 0, 0, 0, 0, time.UTC)
-	}
+	eve := LunarNewYear(year).AddDate(0, 0, -1)
 
 	// Add Eve and the following 6 days (total 7 days celebration)
-	dates = append(dates, eve)
+	dates := []time.Time{eve}
 	for i := 1; i <= 6; i++ {
 		dates = append(dates, eve.AddDate(0, 0, i))
 	}
@@ -293,56 +268,14 @@ func (cn *CNProvider) getQingmingDate(year int) time.Time {
 	}
 }
 
-// getDragonBoatDate returns the date for Dragon Boat Festival
+// getDragonBoatDate returns the date for Dragon Boat Festival, the 5th day of the 5th lunar month
 func (cn *CNProvider) getDragonBoatDate(year int) time.Time {
-	// Lookup table for Dragon Boat Festival
-	switch year {
-	case 2023:
-		return time.Date(2023, 6, 22, 0, 0, 0, 0, time.UTC)
-	case 2024:
-		return time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC)
-	case 2025:
-		return time.Date(2025, 5, 31, 0, 0, 0, 0, time.UTC)
-	case 2026:
-		return time.Date(2026, 6, 19, 0, 0, 0, 0, time.UTC)
-	case 2027:
-		return time.Date(2027, 6, 9, 0, 0, 0, 0, time.UTC)
-	case 2028:
-		return time.Date(2028, 5, 28, 0, 0, 0, 0, time.UTC)
-	case 2029:
-		return time.Date(2029, 6, 16, 0, 0, 0, 0, time.UTC)
-	case 2030:
-		return time.Date(2030, 6, 5, 0, 0, 0, 0, time.UTC)
-	default:
-		// Fallback to an approximation
-		return time.Date(year, 6, 10, 0, 0, 0, 0, time.UTC)
-	}
+	return ChineseLunarDate(year, LunarFifthMonth, 5)
 }
 
-// getMidAutumnDate returns the date for Mid-Autumn Festival
+// getMidAutumnDate returns the date for Mid-Autumn Festival, the 15th day of the 8th lunar month
 func (cn *CNProvider) getMidAutumnDate(year int) time.Time {
-	// Lookup table for Mid-Autumn Festival
-	switch year {
-	case 2023:
-		return time.Date(2023, 9, 29, 0, 0, 0, 0, time.UTC)
-	case 2024:
-		return time.Date(2024, 9, 17, 0, 0, 0, 0, time.UTC)
-	case 2025:
-		return time.Date(2025, 10, 6, 0, 0, 0, 0, time.UTC)
-	case 2026:
-		return time.Date(2026, 9, 25, 0, 0, 0, 0, time.UTC)
-	case 2027:
-		return time.Date(2027, 9, 15, 0, 0, 0, 0, time.UTC)
-	case 2028:
-		return time.Date(2028, 10, 3, 0, 0, 0, 0, time.UTC)
-	case 2029:
-		return time.Date(2029, 9, 22, 0, 0, 0, 0, time.UTC)
-	case 2030:
-		return time.Date(2030, 9, 12, 0, 0, 0, 0, time.UTC)
-	default:
-		// Fallback to an approximation
-		return time.Date(year, 9, 20, 0, 0, 0, 0, time.UTC)
-	}
+	return MidAutumnDate(year)
 }
 
 // GetSpecialObservances returns non-public observances
@@ -424,3 +357,8 @@ func (cn *CNProvider) GetRegionalHolidays(year int, regions []string) map[time.T
 
 	return holidays
 }
+
+// LoadHolidaysForSubdivisions returns the national holidays plus those of the given regions
+func (cn *CNProvider) LoadHolidaysForSubdivisions(year int, regions []string) map[time.Time]*Holiday {
+	return mergeHolidays(cn.LoadHolidays(year), cn.GetRegionalHolidays(year, regions))
+}
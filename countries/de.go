@@ -154,17 +154,6 @@ func (de *DEProvider) LoadHolidays(year int) map[time.Time]*Holiday {
 		},
 	)
 
-	// Easter Sunday
-	holidays[easter] = de.CreateHoliday(
-		"Ostersonntag",
-		easter,
-		"religious",
-		map[string]string{
-			"de": "Ostersonntag",
-			"en": "Easter Sunday",
-		},
-	)
-
 	// Easter Monday
 	easterMonday := easter.AddDate(0, 0, 1)
 	holidays[easterMonday] = de.CreateHoliday(
@@ -234,6 +223,18 @@ func (de *DEProvider) GetRegionalHolidays(year int, states []string) map[time.Ti
 
 	for _, state := range states {
 		switch state {
+		case "BB": // Brandenburg
+			// Easter Sunday is a public holiday only in Brandenburg
+			easter := EasterSunday(year)
+			holidays[easter] = de.CreateHoliday(
+				"Ostersonntag",
+				easter,
+				"public",
+				map[string]string{
+					"de": "Ostersonntag",
+					"en": "Easter Sunday",
+				},
+			)
 		case "BY": // Bavaria
 			// Assumption of Mary - August 15
 			assumption := time.Date(year, 8, 15, 0, 0, 0, 0, time.UTC)
@@ -351,3 +352,8 @@ func (de *DEProvider) GetSpecialObservances(year int) map[time.Time]*Holiday {
 
 	return holidays
 }
+
+// LoadHolidaysForSubdivisions returns the national holidays plus those of the given states
+func (de *DEProvider) LoadHolidaysForSubdivisions(year int, states []string) map[time.Time]*Holiday {
+	return mergeHolidays(de.LoadHolidays(year), de.GetRegionalHolidays(year, states))
+}
@@ -79,16 +79,14 @@ func TestDEProvider_EasterHolidays(t *testing.T) {
 		}
 	}
 
-	// Test Easter Sunday
-	if holiday, exists := holidays[easter]; !exists {
-		t.Error("Ostersonntag should exist")
-	} else {
-		if holiday.Name != "Ostersonntag" {
-			t.Errorf("Expected 'Ostersonntag', got '%s'", holiday.Name)
-		}
-		if holiday.Category != "religious" {
-			t.Errorf("Expected category 'religious', got '%s'", holiday.Category)
-		}
+	// Easter Sunday is only a holiday in Brandenburg
+	if holiday, exists := holidays[easter]; exists {
+		t.Errorf("Ostersonntag should not be a national holiday, got %s", holiday.Name)
+	}
+	if holiday, exists := provider.GetRegionalHolidays(2024, []string{"BB"})[easter]; !exists {
+		t.Error("Ostersonntag should exist in Brandenburg")
+	} else if holiday.Name != "Ostersonntag" {
+		t.Errorf("Expected 'Ostersonntag', got '%s'", holiday.Name)
 	}
 
 	// Test Easter Monday (April 1, 2024)
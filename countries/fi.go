@@ -13,6 +13,7 @@ type FIProvider struct {
 func NewFIProvider() *FIProvider {
 	base := NewBaseProvider("FI")
 	base.categories = []string{"public", "religious"}
+	base.easterSunday = true
 
 	return &FIProvider{BaseProvider: base}
 }
@@ -176,16 +177,18 @@ func (fi *FIProvider) LoadHolidays(year int) map[time.Time]*Holiday {
 	)
 
 	// Easter Sunday
-	holidays[easter] = fi.CreateHoliday(
-		"Pääsiäispäivä",
-		easter,
-		"religious",
-		map[string]string{
-			"fi": "Pääsiäispäivä",
-			"sv": "Påskdagen",
-			"en": "Easter Sunday",
-		},
-	)
+	if fi.ObservesEasterSunday() {
+		holidays[easter] = fi.CreateHoliday(
+			"Pääsiäispäivä",
+			easter,
+			"religious",
+			map[string]string{
+				"fi": "Pääsiäispäivä",
+				"sv": "Påskdagen",
+				"en": "Easter Sunday",
+			},
+		)
+	}
 
 	// Easter Monday
 	easterMonday := easter.AddDate(0, 0, 1)
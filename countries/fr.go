@@ -129,17 +129,6 @@ func (fr *FRProvider) LoadHolidays(year int) map[time.Time]*Holiday {
 	// Easter-based holidays
 	easter := EasterSunday(year)
 
-	// Easter Sunday
-	holidays[easter] = fr.CreateHoliday(
-		"Pâques",
-		easter,
-		"religious",
-		map[string]string{
-			"fr": "Pâques",
-			"en": "Easter Sunday",
-		},
-	)
-
 	// Easter Monday
 	easterMonday := easter.AddDate(0, 0, 1)
 	holidays[easterMonday] = fr.CreateHoliday(
@@ -399,3 +388,8 @@ func (fr *FRProvider) getMothersDay(year int) time.Time {
 
 	return lastSundayMay
 }
+
+// LoadHolidaysForSubdivisions returns the national holidays plus those of the given regions
+func (fr *FRProvider) LoadHolidaysForSubdivisions(year int, regions []string) map[time.Time]*Holiday {
+	return mergeHolidays(fr.LoadHolidays(year), fr.GetRegionalHolidays(year, regions))
+}
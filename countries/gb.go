@@ -79,7 +79,7 @@ func (gb *GBProvider) LoadHolidays(year int) map[time.Time]*Holiday {
 
 	// Variable date holidays
 
-	// Early May Bank Holiday - 1st Monday in May
+	// Early May Bank Holiday - 1st Monday in May (since 1978)
 	earlyMayBankHoliday := NthWeekdayOfMonth(year, 5, time.Monday, 1)
 	holidays[earlyMayBankHoliday] = gb.CreateHoliday(
 		"Early May Bank Holiday",
@@ -89,6 +89,7 @@ func (gb *GBProvider) LoadHolidays(year int) map[time.Time]*Holiday {
 			"en": "Early May Bank Holiday",
 		},
 	)
+	holidays[earlyMayBankHoliday].FromYear = 1978
 
 	// Spring Bank Holiday - Last Monday in May
 	springBankHoliday := NthWeekdayOfMonth(year, 5, time.Monday, -1)
@@ -115,7 +116,60 @@ func (gb *GBProvider) LoadHolidays(year int) map[time.Time]*Holiday {
 	// Special holidays for specific years
 	gb.addSpecialHolidays(year, holidays)
 
-	return holidays
+	// Weekend fixed-date holidays are replaced by the next free weekday
+	gb.addSubstituteDays(year, holidays)
+
+	return inEffect(holidays, year)
+}
+
+// addSubstituteDays adds a substitute day for each fixed-date holiday that falls on a weekend.
+// The substitute is the next weekday that is not already a holiday, so when both Christmas Day
+// and Boxing Day fall on a weekend their substitutes are the following Monday and Tuesday.
+func (gb *GBProvider) addSubstituteDays(year int, holidays map[time.Time]*Holiday) {
+	fixedDates := []time.Time{
+		time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(year, 12, 25, 0, 0, 0, 0, time.UTC),
+		time.Date(year, 12, 26, 0, 0, 0, 0, time.UTC),
+	}
+
+	for _, date := range fixedDates {
+		original, exists := holidays[date]
+		if !exists || (date.Weekday() != time.Saturday && date.Weekday() != time.Sunday) {
+			continue
+		}
+
+		substitute := date.AddDate(0, 0, 1)
+		for {
+			_, taken := holidays[substitute]
+			if !taken && substitute.Weekday() != time.Saturday && substitute.Weekday() != time.Sunday {
+				break
+			}
+			substitute = substitute.AddDate(0, 0, 1)
+		}
+
+		name := original.Name + " (substitute day)"
+		holidays[substitute] = &Holiday{
+			Name:          name,
+			Date:          substitute,
+			Category:      original.Category,
+			Languages:     map[string]string{"en": name},
+			SubstituteFor: original.Name,
+		}
+
+		observed := substitute
+		original.Observed = &observed
+		original.IsObserved = true
+		original.HasSubstitute = true
+	}
+}
+
+// ExpectedHolidayCount returns a rough lower bound on the number of national holidays in a year
+func (gb *GBProvider) ExpectedHolidayCount(year int) int {
+	// The Early May Bank Holiday was added in 1978
+	if year < 1978 {
+		return 7
+	}
+	return 8
 }
 
 // addSpecialHolidays adds one-off special holidays for specific years
@@ -203,3 +257,8 @@ func (gb *GBProvider) GetRegionalHolidays(year int, subdivisions []string) map[t
 
 	return holidays
 }
+
+// LoadHolidaysForSubdivisions returns the national holidays plus those of the given subdivisions
+func (gb *GBProvider) LoadHolidaysForSubdivisions(year int, subdivisions []string) map[time.Time]*Holiday {
+	return mergeHolidays(gb.LoadHolidays(year), gb.GetRegionalHolidays(year, subdivisions))
+}
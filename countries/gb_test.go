@@ -266,3 +266,40 @@ func TestGBProvider_HolidayDateCalculations(t *testing.T) {
 		}
 	}
 }
+
+func TestGBProvider_ExpectedHolidayCount(t *testing.T) {
+	provider := NewGBProvider()
+
+	for _, year := range []int{2000, 2020, 2024} {
+		AssertMinHolidays(t, provider, year, provider.ExpectedHolidayCount(year))
+	}
+}
+
+func TestGBProvider_SubstituteDays(t *testing.T) {
+	provider := NewGBProvider()
+
+	tests := []struct {
+		name          string
+		year          int
+		substitute    time.Time
+		substituteFor string
+	}{
+		{"New Year 2022 Saturday", 2022, time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC), "New Year's Day"},
+		{"Christmas 2021 Saturday", 2021, time.Date(2021, 12, 27, 0, 0, 0, 0, time.UTC), "Christmas Day"},
+		{"Boxing Day 2021 Sunday", 2021, time.Date(2021, 12, 28, 0, 0, 0, 0, time.UTC), "Boxing Day"},
+		{"Christmas 2022 Sunday", 2022, time.Date(2022, 12, 27, 0, 0, 0, 0, time.UTC), "Christmas Day"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			holidays := provider.LoadHolidays(tt.year)
+			holiday, exists := holidays[tt.substitute]
+			if !exists {
+				t.Fatalf("Expected substitute day on %s", tt.substitute.Format("2006-01-02"))
+			}
+			if holiday.SubstituteFor != tt.substituteFor {
+				t.Errorf("Expected substitute for %s, got %q", tt.substituteFor, holiday.SubstituteFor)
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package countries
+
+import (
+	"testing"
+	"time"
+)
+
+// AssertLanguage fails the test for each holiday the provider returns for the year,
+// including those of its subdivisions, without a name in language
+func AssertLanguage(t testing.TB, provider HolidayProvider, year int, language string) {
+	t.Helper()
+
+	holidays := provider.LoadHolidays(year)
+	if subdivisionProvider, ok := provider.(SubdivisionHolidayProvider); ok {
+		holidays = subdivisionProvider.LoadHolidaysForSubdivisions(year, provider.GetSupportedSubdivisions())
+	}
+	for _, holiday := range MissingLanguage(holidays, language) {
+		t.Errorf("%s %d: %s on %s has no %q name",
+			provider.GetCountryCode(), year, holiday.Name, holiday.Date.Format(time.DateOnly), language)
+	}
+}
+
+// AssertMinHolidays fails the test if the provider returns fewer than min holidays for the year
+func AssertMinHolidays(t testing.TB, provider HolidayProvider, year, min int) {
+	t.Helper()
+
+	holidays := provider.LoadHolidays(year)
+	if len(holidays) < min {
+		t.Errorf("%s %d: expected at least %d holidays, got %d",
+			provider.GetCountryCode(), year, min, len(holidays))
+	}
+}
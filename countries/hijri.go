@@ -0,0 +1,104 @@
+package countries
+
+import (
+	"math"
+	"time"
+)
+
+// Hijri months are lunar: each begins on the evening the new crescent can first be
+// seen, so the Gregorian dates of Islamic holidays drift about 11 days earlier every
+// year. Official dates depend on local sightings or national calculation rules; the
+// dates here follow an astronomical rule close to Saudi Arabia's Umm al-Qura calendar,
+// starting a month on the day after a conjunction that occurs before sunset in Mecca.
+// Countries whose announcements run a day or two apart pass an offset to shift them.
+
+// Hijri months, numbered from Muharram
+const (
+	HijriMuharram     = 1
+	HijriSafar        = 2
+	HijriRabiAlAwwal  = 3
+	HijriRabiAlThani  = 4
+	HijriJumadaAlUla  = 5
+	HijriJumadaAlAkhi = 6
+	HijriRajab        = 7
+	HijriShaban       = 8
+	HijriRamadan      = 9
+	HijriShawwal      = 10
+	HijriDhuAlQadah   = 11
+	HijriDhuAlHijjah  = 12
+)
+
+const (
+	hijriEpoch        = 1948439.5 // Julian day of 1 Muharram 1 AH (16 July 622, Julian calendar)
+	hijriMeccaOffset  = 3.0 / 24  // Mecca time (UTC+3) as a fraction of a day
+	hijriMeccaSunset  = 18.0 / 24 // approximate sunset in Mecca, as a fraction of the local day
+	hijriMonthsInYear = 12
+)
+
+// islamicHolidays are the holidays returned by IslamicHolidays, by Hijri month and day
+var islamicHolidays = []struct {
+	month, day int
+	name       string
+}{
+	{HijriMuharram, 1, "Islamic New Year"},
+	{HijriMuharram, 10, "Ashura"},
+	{HijriRabiAlAwwal, 12, "Mawlid"},
+	{HijriRajab, 27, "Isra and Mi'raj"},
+	{HijriRamadan, 1, "Start of Ramadan"},
+	{HijriShawwal, 1, "Eid al-Fitr"},
+	{HijriDhuAlHijjah, 9, "Day of Arafah"},
+	{HijriDhuAlHijjah, 10, "Eid al-Adha"},
+}
+
+// IslamicHolidays returns the key Islamic holidays falling in gregorianYear, keyed by
+// date. Every date is shifted by offset days to follow a country's announced dates.
+func IslamicHolidays(gregorianYear, offset int) map[time.Time]string {
+	holidays := make(map[time.Time]string)
+	for _, h := range islamicHolidays {
+		for _, date := range HijriDates(gregorianYear, h.month, h.day, offset) {
+			holidays[date] = h.name
+		}
+	}
+	return holidays
+}
+
+// HijriDates returns the dates in gregorianYear that fall on the given Hijri month and
+// day, shifted by offset days. A Hijri year is 11 days shorter than a Gregorian one,
+// so a date occurs once in most years and twice in some.
+func HijriDates(gregorianYear, month, day, offset int) []time.Time {
+	var dates []time.Time
+
+	// The Hijri years that can overlap gregorianYear
+	first := int(math.Floor(float64(gregorianYear-622)*33/32)) - 1
+	for hijriYear := first; hijriYear <= first+3; hijriYear++ {
+		if hijriYear < 1 {
+			continue
+		}
+		date := HijriToGregorian(hijriYear, month, day).AddDate(0, 0, offset)
+		if date.Year() == gregorianYear {
+			dates = append(dates, date)
+		}
+	}
+	return dates
+}
+
+// HijriToGregorian returns the Gregorian date of the given Hijri date
+func HijriToGregorian(year, month, day int) time.Time {
+	return hijriMonthStart(year, month).AddDate(0, 0, day-1)
+}
+
+// hijriMonthStart returns the first day of a Hijri month: the day after the evening
+// following its conjunction, or the day after that if the conjunction falls after sunset
+func hijriMonthStart(year, month int) time.Time {
+	lunation := (year-1)*hijriMonthsInYear + month - 1
+	conjunction := elongationTime(0, hijriEpoch+float64(lunation)*synodicMonth) + hijriMeccaOffset
+
+	// Julian days begin at noon, so the local civil day starts at the half day
+	localDay := math.Floor(conjunction + 0.5)
+	if conjunction+0.5-localDay >= hijriMeccaSunset {
+		localDay++
+	}
+
+	start := fromJulianDay(localDay - 0.5 + 1)
+	return time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+}
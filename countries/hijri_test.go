@@ -0,0 +1,79 @@
+package countries
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHijriToGregorian(t *testing.T) {
+	tests := []struct {
+		name                            string
+		year, month, day                int
+		expectedY, expectedM, expectedD int
+	}{
+		{"Eid al-Fitr 1441", 1441, HijriShawwal, 1, 2020, 5, 24},
+		{"Eid al-Fitr 1445", 1445, HijriShawwal, 1, 2024, 4, 10},
+		{"Eid al-Fitr 1446", 1446, HijriShawwal, 1, 2025, 3, 30},
+		{"Eid al-Adha 1440", 1440, HijriDhuAlHijjah, 10, 2019, 8, 11},
+		{"Eid al-Adha 1445", 1445, HijriDhuAlHijjah, 10, 2024, 6, 16},
+		{"Eid al-Adha 1446", 1446, HijriDhuAlHijjah, 10, 2025, 6, 6},
+		{"Islamic New Year 1444", 1444, HijriMuharram, 1, 2022, 7, 30},
+		{"Islamic New Year 1446", 1446, HijriMuharram, 1, 2024, 7, 7},
+		{"Ramadan 1445", 1445, HijriRamadan, 1, 2024, 3, 11},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expected := time.Date(tt.expectedY, time.Month(tt.expectedM), tt.expectedD, 0, 0, 0, 0, time.UTC)
+			if got := HijriToGregorian(tt.year, tt.month, tt.day); !got.Equal(expected) {
+				t.Errorf("HijriToGregorian(%d, %d, %d) = %s, expected %s",
+					tt.year, tt.month, tt.day, got.Format("2006-01-02"), expected.Format("2006-01-02"))
+			}
+		})
+	}
+}
+
+func TestHijriDates(t *testing.T) {
+	// The Hijri year is about 11 days shorter, so 1 Muharram fell twice in 2008
+	dates := HijriDates(2008, HijriMuharram, 1, 0)
+	if len(dates) != 2 {
+		t.Fatalf("Expected 1 Muharram twice in 2008, got %v", dates)
+	}
+	if dates[0].Month() != time.January || dates[1].Month() != time.December {
+		t.Errorf("Expected 1 Muharram in January and December 2008, got %v", dates)
+	}
+
+	// An offset shifts every date by whole days
+	base := HijriDates(2024, HijriShawwal, 1, 0)
+	shifted := HijriDates(2024, HijriShawwal, 1, 1)
+	if len(base) != 1 || len(shifted) != 1 || !shifted[0].Equal(base[0].AddDate(0, 0, 1)) {
+		t.Errorf("Expected an offset of 1 to shift %v by one day, got %v", base, shifted)
+	}
+}
+
+func TestIslamicHolidays(t *testing.T) {
+	holidays := IslamicHolidays(2024, 0)
+
+	expected := map[time.Time]string{
+		time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC): "Start of Ramadan",
+		time.Date(2024, 4, 10, 0, 0, 0, 0, time.UTC): "Eid al-Fitr",
+		time.Date(2024, 6, 16, 0, 0, 0, 0, time.UTC): "Eid al-Adha",
+		time.Date(2024, 7, 7, 0, 0, 0, 0, time.UTC):  "Islamic New Year",
+	}
+	for date, name := range expected {
+		if holidays[date] != name {
+			t.Errorf("Expected %s on %s, got %q", name, date.Format("2006-01-02"), holidays[date])
+		}
+	}
+
+	// Every year has both Eids
+	for year := 2000; year <= 2050; year++ {
+		found := map[string]bool{}
+		for _, name := range IslamicHolidays(year, 0) {
+			found[name] = true
+		}
+		if !found["Eid al-Fitr"] || !found["Eid al-Adha"] {
+			t.Errorf("Expected both Eids in %d, got %v", year, found)
+		}
+	}
+}
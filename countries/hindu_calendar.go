@@ -0,0 +1,231 @@
+package countries
+
+import (
+	"math"
+	"time"
+)
+
+// This file computes Hindu festival dates from the amanta lunisolar calendar used for
+// India's gazetted holidays. Lunar months run from one new moon to the next and are
+// named after the sidereal zodiac sign (rashi) the Sun occupies when the month begins;
+// a month during which the Sun does not change sign is an intercalary (adhika) month,
+// and festivals fall in the regular (nija) month that follows it. A tithi is the time
+// the Moon takes to gain 12 degrees of elongation on the Sun, and a festival is kept on
+// the civil day whose sunrise or sunset in India falls within its tithi.
+//
+// Positions use truncated series from Meeus, "Astronomical Algorithms", which place
+// tithi boundaries within a few minutes over 2000-2050. Sunrise and sunset are taken
+// as 06:00 and 18:00 IST, so a tithi ending close to either can land a day off.
+
+// Hindu lunar months (amanta), numbered from Chaitra
+const (
+	hinduChaitra = iota
+	hinduVaishakha
+	hinduJyeshtha
+	hinduAshadha
+	hinduShravana
+	hinduBhadrapada
+	hinduAshvina
+	hinduKartika
+	hinduMargashirsha
+	hinduPausha
+	hinduMagha
+	hinduPhalguna
+)
+
+const (
+	synodicMonth   = 29.530588861 // mean days between new moons
+	julianDayUnix  = 2440587.5    // Julian day of the Unix epoch
+	julianDayJ2000 = 2451545.0    // Julian day of J2000.0
+)
+
+var (
+	istZone      = time.FixedZone("IST", 5*3600+30*60)
+	indiaSunrise = 6 * time.Hour
+	indiaSunset  = 18 * time.Hour
+)
+
+// moonTerms are the largest periodic terms of the Moon's longitude as multiples of
+// D, M, M' and F with their amplitude in millionths of a degree
+var moonTerms = [][5]float64{
+	{0, 0, 1, 0, 6288774}, {2, 0, -1, 0, 1274027}, {2, 0, 0, 0, 658314},
+	{0, 0, 2, 0, 213618}, {0, 1, 0, 0, -185116}, {0, 0, 0, 2, -114332},
+	{2, 0, -2, 0, 58793}, {2, -1, -1, 0, 57066}, {2, 0, 1, 0, 53322},
+	{2, -1, 0, 0, 45758}, {0, 1, -1, 0, -40923}, {1, 0, 0, 0, -34720},
+	{0, 1, 1, 0, -30383}, {2, 0, 0, -2, 15327}, {0, 0, 1, 2, -12528},
+	{0, 0, 1, -2, 10980}, {4, 0, -1, 0, 10675}, {0, 0, 3, 0, 10034},
+	{4, 0, -2, 0, 8548}, {2, 1, -1, 0, -7888}, {2, 1, 0, 0, -6766},
+	{1, 0, -1, 0, -5163}, {1, 1, 0, 0, 4987}, {2, -1, 1, 0, 4036},
+	{2, 0, 2, 0, 3994},
+}
+
+// DiwaliDate returns Diwali (Lakshmi Puja) for year: the day on whose evening the
+// Amavasya (new moon tithi) ending the month of Ashvina prevails. In the purnimanta
+// reckoning used in northern India this is the Amavasya of Kartik.
+func DiwaliDate(year int) time.Time {
+	for _, lunation := range hinduLunations(year) {
+		if lunation.month == hinduAshvina && !lunation.adhika {
+			start, end := lunation.tithi(30)
+			if date := tithiDay(start, end, indiaSunset); date.Year() == year {
+				return date
+			}
+		}
+	}
+	return time.Time{}
+}
+
+// HoliDate returns Holi (Dhulandi), the day after Holika Dahan, which is kept on the
+// evening of the Purnima (full moon tithi) of Phalguna
+func HoliDate(year int) time.Time {
+	for _, lunation := range hinduLunations(year) {
+		if lunation.month == hinduPhalguna && !lunation.adhika {
+			start, end := lunation.tithi(15)
+			if date := tithiDay(start, end, indiaSunset).AddDate(0, 0, 1); date.Year() == year {
+				return date
+			}
+		}
+	}
+	return time.Time{}
+}
+
+// JanmashtamiDate returns Krishna Janmashtami for year: the day whose sunrise falls in
+// the Ashtami (eighth tithi) of the waning half of Shravana, which the purnimanta
+// reckoning calls Bhadrapada
+func JanmashtamiDate(year int) time.Time {
+	for _, lunation := range hinduLunations(year) {
+		if lunation.month == hinduShravana && !lunation.adhika {
+			start, end := lunation.tithi(23)
+			if date := tithiDay(start, end, indiaSunrise); date.Year() == year {
+				return date
+			}
+		}
+	}
+	return time.Time{}
+}
+
+// hinduLunation is a lunar month running from one new moon (in Julian days) to the next
+type hinduLunation struct {
+	start, end float64
+	month      int
+	adhika     bool
+}
+
+// tithi returns the start and end, in Julian days, of the nth tithi (1-30) of the month
+func (l hinduLunation) tithi(n int) (start, end float64) {
+	start = l.start
+	if n > 1 {
+		start = elongationTime(float64(n-1)*12, l.start+float64(n-1)*synodicMonth/30)
+	}
+	end = l.end
+	if n < 30 {
+		end = elongationTime(float64(n)*12, l.start+float64(n)*synodicMonth/30)
+	}
+	return start, end
+}
+
+// hinduLunations returns the lunar months overlapping year, from the last new moon of
+// November of the previous year to the first of the following year
+func hinduLunations(year int) []hinduLunation {
+	var newMoons []float64
+	newMoon := elongationTime(0, julianDay(time.Date(year-1, time.November, 15, 0, 0, 0, 0, time.UTC)))
+	limit := julianDay(time.Date(year+1, time.February, 1, 0, 0, 0, 0, time.UTC))
+	for newMoon < limit {
+		newMoons = append(newMoons, newMoon)
+		newMoon = elongationTime(0, newMoon+synodicMonth)
+	}
+
+	lunations := make([]hinduLunation, 0, len(newMoons)-1)
+	for i := 0; i+1 < len(newMoons); i++ {
+		rashi := siderealRashi(newMoons[i])
+		lunations = append(lunations, hinduLunation{
+			start:  newMoons[i],
+			end:    newMoons[i+1],
+			month:  (rashi + 1) % 12,
+			adhika: rashi == siderealRashi(newMoons[i+1]),
+		})
+	}
+	return lunations
+}
+
+// tithiDay returns the civil date in India whose local time of day at falls within the
+// tithi from start to end, or the date the tithi begins if it spans no such moment
+func tithiDay(start, end float64, at time.Duration) time.Time {
+	local := fromJulianDay(start).In(istZone)
+	first := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+	for offset := 0; offset < 2; offset++ {
+		day := time.Date(local.Year(), local.Month(), local.Day()+offset, 0, 0, 0, 0, istZone)
+		if moment := julianDay(day.Add(at)); moment >= start && moment < end {
+			return first.AddDate(0, 0, offset)
+		}
+	}
+	return first
+}
+
+// elongationTime returns the Julian day near guess when the Moon is target degrees
+// east of the Sun
+func elongationTime(target, guess float64) float64 {
+	jd := guess
+	for i := 0; i < 20; i++ {
+		delta := normalizeDegrees(moonLongitude(jd)-sunLongitude(jd)-target+180) - 180
+		jd -= delta / 12.190749
+		if math.Abs(delta) < 1e-6 {
+			break
+		}
+	}
+	return jd
+}
+
+// siderealRashi returns the sidereal zodiac sign (0 for Mesha) the Sun occupies at jd,
+// using the Lahiri ayanamsa
+func siderealRashi(jd float64) int {
+	t := (jd - julianDayJ2000) / 36525
+	ayanamsa := 23.85306 + 1.39697*t
+	return int(normalizeDegrees(sunLongitude(jd)-ayanamsa) / 30)
+}
+
+// sunLongitude returns the Sun's geometric ecliptic longitude in degrees at jd
+func sunLongitude(jd float64) float64 {
+	t := (jd - julianDayJ2000) / 36525
+	l0 := 280.46646 + 36000.76983*t
+	m := radians(357.52911 + 35999.05029*t)
+	c := (1.914602-0.004817*t)*math.Sin(m) + (0.019993-0.000101*t)*math.Sin(2*m) + 0.000289*math.Sin(3*m)
+	return normalizeDegrees(l0 + c)
+}
+
+// moonLongitude returns the Moon's geocentric ecliptic longitude in degrees at jd
+func moonLongitude(jd float64) float64 {
+	t := (jd - julianDayJ2000) / 36525
+	l := 218.3164477 + 481267.88123421*t
+	d := radians(297.8501921 + 445267.1114034*t)
+	m := radians(357.5291092 + 35999.0502909*t)
+	mp := radians(134.9633964 + 477198.8675055*t)
+	f := radians(93.2720950 + 483202.0175233*t)
+
+	var sum float64
+	for _, term := range moonTerms {
+		sum += term[4] * math.Sin(term[0]*d+term[1]*m+term[2]*mp+term[3]*f)
+	}
+	return normalizeDegrees(l + sum/1e6)
+}
+
+// julianDay converts t to a Julian day
+func julianDay(t time.Time) float64 {
+	return float64(t.Unix())/86400 + julianDayUnix
+}
+
+// fromJulianDay converts a Julian day to a UTC time, to the nearest second
+func fromJulianDay(jd float64) time.Time {
+	return time.Unix(int64(math.Round((jd-julianDayUnix)*86400)), 0).UTC()
+}
+
+func radians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
+
+func normalizeDegrees(degrees float64) float64 {
+	degrees = math.Mod(degrees, 360)
+	if degrees < 0 {
+		degrees += 360
+	}
+	return degrees
+}
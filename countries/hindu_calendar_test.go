@@ -0,0 +1,70 @@
+package countries
+
+import (
+	"testing"
+	"time"
+)
+
+// assertWithinADay fails the test unless got is within one day of the gazetted date
+func assertWithinADay(t *testing.T, festival string, got time.Time, year int, month time.Month, day int) {
+	t.Helper()
+
+	expected := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	if diff := got.Sub(expected); diff < -24*time.Hour || diff > 24*time.Hour {
+		t.Errorf("%s %d: expected %s (±1 day), got %s",
+			festival, year, expected.Format("2006-01-02"), got.Format("2006-01-02"))
+	}
+}
+
+func TestDiwaliDate(t *testing.T) {
+	gazetted := map[int][2]int{
+		2000: {10, 26}, 2001: {11, 14}, 2002: {11, 4}, 2003: {10, 25}, 2004: {11, 12},
+		2005: {11, 1}, 2006: {10, 21}, 2007: {11, 9}, 2008: {10, 28}, 2009: {10, 17},
+		2010: {11, 5}, 2011: {10, 26}, 2012: {11, 13}, 2013: {11, 3}, 2014: {10, 23},
+		2015: {11, 11}, 2016: {10, 30}, 2017: {10, 19}, 2018: {11, 7}, 2019: {10, 27},
+		2020: {11, 14}, 2021: {11, 4}, 2022: {10, 24}, 2023: {11, 12}, 2024: {10, 31},
+		2025: {10, 20}, 2026: {11, 8}, 2027: {10, 29}, 2028: {10, 17}, 2029: {11, 5},
+		2030: {10, 26},
+	}
+
+	for year, date := range gazetted {
+		assertWithinADay(t, "Diwali", DiwaliDate(year), year, time.Month(date[0]), date[1])
+	}
+}
+
+func TestHoliDate(t *testing.T) {
+	gazetted := map[int][2]int{
+		2015: {3, 6}, 2016: {3, 24}, 2017: {3, 13}, 2018: {3, 2}, 2019: {3, 21},
+		2020: {3, 10}, 2021: {3, 29}, 2022: {3, 18}, 2023: {3, 8}, 2024: {3, 25},
+		2025: {3, 14}, 2026: {3, 4},
+	}
+
+	for year, date := range gazetted {
+		assertWithinADay(t, "Holi", HoliDate(year), year, time.Month(date[0]), date[1])
+	}
+}
+
+func TestJanmashtamiDate(t *testing.T) {
+	gazetted := map[int][2]int{
+		2018: {9, 3}, 2019: {8, 24}, 2020: {8, 12}, 2021: {8, 30}, 2022: {8, 19},
+		2023: {9, 7}, 2024: {8, 26}, 2025: {8, 16},
+	}
+
+	for year, date := range gazetted {
+		assertWithinADay(t, "Janmashtami", JanmashtamiDate(year), year, time.Month(date[0]), date[1])
+	}
+}
+
+func TestHinduFestivalsInSeason(t *testing.T) {
+	for year := 2000; year <= 2050; year++ {
+		if holi := HoliDate(year); holi.Month() < time.February || holi.Month() > time.April {
+			t.Errorf("Holi %d: expected February to April, got %s", year, holi.Format("2006-01-02"))
+		}
+		if janmashtami := JanmashtamiDate(year); janmashtami.Month() < time.August || janmashtami.Month() > time.September {
+			t.Errorf("Janmashtami %d: expected August or September, got %s", year, janmashtami.Format("2006-01-02"))
+		}
+		if diwali := DiwaliDate(year); diwali.Month() < time.October || diwali.Month() > time.November {
+			t.Errorf("Diwali %d: expected October or November, got %s", year, diwali.Format("2006-01-02"))
+		}
+	}
+}
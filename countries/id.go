@@ -102,82 +102,33 @@ func (p *IDProvider) addFixedHolidays(holidays map[time.Time]*Holiday, year int)
 
 // addIslamicHolidays adds Islamic holidays (Indonesia has the largest Muslim population)
 func (p *IDProvider) addIslamicHolidays(holidays map[time.Time]*Holiday, year int) {
-	// Islamic New Year
-	islamicNewYear := p.getIslamicHolidayDate(year, "islamic_new_year")
-	if !islamicNewYear.IsZero() {
-		holidays[islamicNewYear] = p.CreateHoliday(
-			"Tahun Baru Islam",
-			islamicNewYear,
-			"islamic",
-			map[string]string{
-				"id": "Tahun Baru Islam",
-				"en": "Islamic New Year",
-			},
-		)
-	}
-
-	// Maulid (Prophet Muhammad's Birthday)
-	maulid := p.getIslamicHolidayDate(year, "maulid")
-	if !maulid.IsZero() {
-		holidays[maulid] = p.CreateHoliday(
-			"Maulid Nabi Muhammad SAW",
-			maulid,
-			"islamic",
-			map[string]string{
-				"id": "Maulid Nabi Muhammad SAW",
-				"en": "Prophet Muhammad's Birthday",
-			},
-		)
+	islamicHolidays := []struct {
+		month  int
+		day    int
+		name   string
+		nameEN string
+	}{
+		{HijriMuharram, 1, "Tahun Baru Islam", "Islamic New Year"},
+		{HijriRabiAlAwwal, 12, "Maulid Nabi Muhammad SAW", "Prophet Muhammad's Birthday"},
+		{HijriRajab, 27, "Isra Mi'raj", "Isra and Mi'raj"},
+		{HijriShawwal, 1, "Hari Raya Idul Fitri", "Eid al-Fitr Day 1"},
+		{HijriShawwal, 2, "Hari Raya Idul Fitri Kedua", "Eid al-Fitr Day 2"},
+		{HijriDhuAlHijjah, 10, "Hari Raya Idul Adha", "Eid al-Adha"},
 	}
 
-	// Isra Mi'raj
-	israMiraj := p.getIslamicHolidayDate(year, "isra_miraj")
-	if !israMiraj.IsZero() {
-		holidays[israMiraj] = p.CreateHoliday(
-			"Isra Mi'raj",
-			israMiraj,
-			"islamic",
-			map[string]string{
-				"id": "Isra Mi'raj",
-				"en": "Isra and Mi'raj",
-			},
-		)
-	}
-
-	// Eid al-Fitr (Idul Fitri) - 2 days
-	iduFitri := p.getIslamicHolidayDate(year, "idul_fitri")
-	if !iduFitri.IsZero() {
-		for i := 0; i < 2; i++ {
-			date := iduFitri.AddDate(0, 0, i)
-			dayName := "Hari Raya Idul Fitri"
-			if i == 1 {
-				dayName += " Kedua"
-			}
+	for _, h := range islamicHolidays {
+		for _, date := range HijriDates(year, h.month, h.day, p.hijriOffset) {
 			holidays[date] = p.CreateHoliday(
-				dayName,
+				h.name,
 				date,
 				"islamic",
 				map[string]string{
-					"id": dayName,
-					"en": "Eid al-Fitr Day " + string(rune('1'+i)),
+					"id": h.name,
+					"en": h.nameEN,
 				},
 			)
 		}
 	}
-
-	// Eid al-Adha (Idul Adha)
-	iduAdha := p.getIslamicHolidayDate(year, "idul_adha")
-	if !iduAdha.IsZero() {
-		holidays[iduAdha] = p.CreateHoliday(
-			"Hari Raya Idul Adha",
-			iduAdha,
-			"islamic",
-			map[string]string{
-				"id": "Hari Raya Idul Adha",
-				"en": "Eid al-Adha",
-			},
-		)
-	}
 }
 
 // addChristianHolidays adds Christian holidays
@@ -264,33 +215,6 @@ func (p *IDProvider) addOtherReligiousHolidays(holidays map[time.Time]*Holiday,
 	}
 }
 
-// getIslamicHolidayDate returns approximate dates for Islamic holidays
-func (p *IDProvider) getIslamicHolidayDate(year int, holiday string) time.Time {
-	islamicHolidays := map[int]map[string]time.Time{
-		2024: {
-			"islamic_new_year": time.Date(2024, 7, 7, 0, 0, 0, 0, time.UTC),
-			"maulid":           time.Date(2024, 9, 15, 0, 0, 0, 0, time.UTC),
-			"isra_miraj":       time.Date(2024, 2, 8, 0, 0, 0, 0, time.UTC),
-			"idul_fitri":       time.Date(2024, 4, 10, 0, 0, 0, 0, time.UTC),
-			"idul_adha":        time.Date(2024, 6, 16, 0, 0, 0, 0, time.UTC),
-		},
-		2025: {
-			"islamic_new_year": time.Date(2025, 6, 26, 0, 0, 0, 0, time.UTC),
-			"maulid":           time.Date(2025, 9, 5, 0, 0, 0, 0, time.UTC),
-			"isra_miraj":       time.Date(2025, 1, 28, 0, 0, 0, 0, time.UTC),
-			"idul_fitri":       time.Date(2025, 3, 30, 0, 0, 0, 0, time.UTC),
-			"idul_adha":        time.Date(2025, 6, 6, 0, 0, 0, 0, time.UTC),
-		},
-	}
-
-	if yearHolidays, exists := islamicHolidays[year]; exists {
-		if date, exists := yearHolidays[holiday]; exists {
-			return date
-		}
-	}
-	return time.Time{}
-}
-
 // getChineseNewYearDate returns Chinese New Year date for the year
 func (p *IDProvider) getChineseNewYearDate(year int) time.Time {
 	chineseNewYearDates := map[int]time.Time{
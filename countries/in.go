@@ -44,7 +44,7 @@ func (in *INProvider) LoadHolidays(year int) map[time.Time]*Holiday {
 	// and would require complex astronomical calculations. For now, we include
 	// the major fixed ones and note that lunar-based holidays need special handling.
 
-	return holidays
+	return inEffect(holidays, year)
 }
 
 // addNationalHolidays adds fixed national holidays of India
@@ -55,11 +55,12 @@ func (in *INProvider) addNationalHolidays(holidays map[time.Time]*Holiday, year
 		name     string
 		nameHi   string
 		category string
+		fromYear int
 	}{
-		{1, 26, "Republic Day", "गणतंत्र दिवस", "national"},
-		{8, 15, "Independence Day", "स्वतंत्रता दिवस", "national"},
-		{10, 2, "Gandhi Jayanti", "गांधी जयंती", "national"},
-		{12, 25, "Christmas Day", "क्रिसमस", "christian"},
+		{1, 26, "Republic Day", "गणतंत्र दिवस", "national", 1950},
+		{8, 15, "Independence Day", "स्वतंत्रता दिवस", "national", 1947},
+		{10, 2, "Gandhi Jayanti", "गांधी जयंती", "national", 0},
+		{12, 25, "Christmas Day", "क्रिसमस", "christian", 0},
 	}
 
 	for _, h := range nationalHolidays {
@@ -73,6 +74,7 @@ func (in *INProvider) addNationalHolidays(holidays map[time.Time]*Holiday, year
 				"hi": h.nameHi,
 			},
 			IsObserved: true,
+			FromYear:   h.fromYear,
 		}
 	}
 }
@@ -87,7 +89,6 @@ func (in *INProvider) addChristianHolidays(holidays map[time.Time]*Holiday, year
 		category string
 	}{
 		{-2, "Good Friday", "christian"},
-		{0, "Easter Sunday", "christian"},
 	}
 
 	for _, h := range christianHolidays {
@@ -178,7 +179,8 @@ func (in *INProvider) GetStateHolidays(year int, state string) map[time.Time]*Ho
 }
 
 // Note: For a production implementation of Indian holidays, you would need:
-// 1. Hindu calendar calculations for festivals like Diwali, Holi, Dussehra
+// 1. Hindu calendar calculations for the remaining festivals, such as Dussehra
+//    (Diwali, Holi and Janmashtami are computed in hindu_calendar.go)
 // 2. Islamic calendar calculations for Eid al-Fitr, Eid al-Adha, Muharram
 // 3. Sikh calendar for Guru Nanak Jayanti, etc.
 // 4. Buddhist calendar for Buddha Purnima
@@ -188,8 +190,8 @@ func (in *INProvider) GetStateHolidays(year int, state string) map[time.Time]*Ho
 // These require complex astronomical calculations and calendar conversions
 // that are beyond the scope of this basic implementation.
 
-// GetMajorFestivals returns approximate dates for major Indian festivals
-// Note: These are approximate dates as actual dates depend on lunar calculations
+// GetMajorFestivals returns dates for major Indian festivals. Holi, Diwali and
+// Janmashtami are computed from the Hindu lunar calendar; the others are approximate.
 func (in *INProvider) GetMajorFestivals(year int) map[time.Time]*Holiday {
 	holidays := make(map[time.Time]*Holiday)
 
@@ -200,10 +202,7 @@ func (in *INProvider) GetMajorFestivals(year int) map[time.Time]*Holiday {
 		name     string
 		category string
 	}{
-		{3, 8, "Holi", "hindu"},                // Approximate - varies by lunar calendar
 		{10, 24, "Dussehra", "hindu"},          // Approximate
-		{11, 12, "Diwali", "hindu"},            // Approximate
-		{8, 19, "Janmashtami", "hindu"},        // Approximate
 		{4, 6, "Ram Navami", "hindu"},          // Approximate
 		{5, 23, "Buddha Purnima", "buddhist"},  // Approximate
 		{4, 14, "Mahavir Jayanti", "jain"},     // Approximate
@@ -223,5 +222,36 @@ func (in *INProvider) GetMajorFestivals(year int) map[time.Time]*Holiday {
 		}
 	}
 
+	// Computed dates take precedence over an approximate one on the same day
+	computedFestivals := []struct {
+		date time.Time
+		name string
+	}{
+		{HoliDate(year), "Holi"},
+		{JanmashtamiDate(year), "Janmashtami"},
+		{DiwaliDate(year), "Diwali"},
+	}
+
+	for _, h := range computedFestivals {
+		holidays[h.date] = &Holiday{
+			Name:     h.name,
+			Date:     h.date,
+			Category: "hindu",
+			Languages: map[string]string{
+				"en": h.name,
+			},
+			IsObserved: true,
+		}
+	}
+
+	return holidays
+}
+
+// LoadHolidaysForSubdivisions returns the national holidays plus those of the given states
+func (in *INProvider) LoadHolidaysForSubdivisions(year int, states []string) map[time.Time]*Holiday {
+	holidays := in.LoadHolidays(year)
+	for _, state := range states {
+		holidays = mergeHolidays(holidays, in.GetStateHolidays(year, state))
+	}
 	return holidays
 }
@@ -51,8 +51,7 @@ func TestINProvider_LoadHolidays2024(t *testing.T) {
 
 	// Test Christian holidays for 2024 (Easter was March 31, 2024)
 	christianHolidays := map[string]time.Time{
-		"Good Friday":   time.Date(2024, 3, 29, 0, 0, 0, 0, time.UTC), // Easter - 2 days
-		"Easter Sunday": time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC), // Easter
+		"Good Friday": time.Date(2024, 3, 29, 0, 0, 0, 0, time.UTC), // Easter - 2 days
 	}
 
 	for name, expectedDate := range christianHolidays {
@@ -69,8 +68,8 @@ func TestINProvider_LoadHolidays2024(t *testing.T) {
 	}
 
 	// Check minimum number of holidays
-	if len(holidays) < 6 {
-		t.Errorf("Expected at least 6 holidays, got %d", len(holidays))
+	if len(holidays) < 5 {
+		t.Errorf("Expected at least 5 holidays, got %d", len(holidays))
 	}
 }
 
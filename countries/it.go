@@ -172,3 +172,12 @@ func (it *ITProvider) GetRegionalHolidays(year int, region string) map[time.Time
 
 	return holidays
 }
+
+// LoadHolidaysForSubdivisions returns the national holidays plus those of the given regions
+func (it *ITProvider) LoadHolidaysForSubdivisions(year int, regions []string) map[time.Time]*Holiday {
+	holidays := it.LoadHolidays(year)
+	for _, region := range regions {
+		holidays = mergeHolidays(holidays, it.GetRegionalHolidays(year, region))
+	}
+	return holidays
+}
@@ -0,0 +1,203 @@
+package countries
+
+import (
+	"sort"
+	"time"
+)
+
+// CountryData represents holiday data for a country, as written by the sync tool
+type CountryData struct {
+	CountryCode  string                       `json:"country_code"`
+	Name         string                       `json:"name"`
+	Subdivisions map[string]string            `json:"subdivisions,omitempty"`
+	Categories   []string                     `json:"categories"`
+	Languages    []string                     `json:"languages"`
+	Holidays     map[string]HolidayDefinition `json:"holidays"`
+	UpdatedAt    time.Time                    `json:"updated_at"`
+}
+
+// HolidayDefinition represents a holiday definition from Python source
+type HolidayDefinition struct {
+	Name         string            `json:"name"`
+	Category     string            `json:"category"`
+	Languages    map[string]string `json:"languages"`
+	Calculation  string            `json:"calculation"` // "fixed", "easter_based", "weekday_based"
+	Month        int               `json:"month,omitempty"`
+	Day          int               `json:"day,omitempty"`
+	EasterOffset int               `json:"easter_offset,omitempty"`
+	WeekdayRule  *WeekdayRule      `json:"weekday_rule,omitempty"`
+	YearRange    *YearRange        `json:"year_range,omitempty"`
+	Subdivisions []string          `json:"subdivisions,omitempty"`
+}
+
+// WeekdayRule defines rules for weekday-based holidays
+type WeekdayRule struct {
+	Month      int          `json:"month"`
+	Weekday    time.Weekday `json:"weekday"`
+	Occurrence int          `json:"occurrence"` // 1=first, 2=second, -1=last
+}
+
+// YearRange defines the valid year range for a holiday
+type YearRange struct {
+	Start int `json:"start,omitempty"`
+	End   int `json:"end,omitempty"`
+}
+
+// JSONProvider serves the holidays of a country described by CountryData, such as a
+// file written by the sync tool, by evaluating each definition's calculation rule
+type JSONProvider struct {
+	*BaseProvider
+	data CountryData
+}
+
+// NewJSONProvider creates a provider for the holidays defined in data
+func NewJSONProvider(data CountryData) *JSONProvider {
+	base := NewBaseProvider(data.CountryCode)
+	// The definitions carry no observance rules, so dates are reported as defined
+	base.observedShift = false
+
+	for code := range data.Subdivisions {
+		base.subdivisions = append(base.subdivisions, code)
+	}
+	sort.Strings(base.subdivisions)
+	if len(data.Categories) > 0 {
+		base.categories = data.Categories
+	}
+
+	return &JSONProvider{BaseProvider: base, data: data}
+}
+
+// LoadHolidays returns the national holidays, those without subdivisions, for year
+func (p *JSONProvider) LoadHolidays(year int) map[time.Time]*Holiday {
+	holidays := make(map[time.Time]*Holiday)
+	for _, definition := range p.sortedDefinitions() {
+		if len(definition.Subdivisions) > 0 {
+			continue
+		}
+		if holiday := definition.holiday(year); holiday != nil {
+			holidays[holiday.Date] = holiday
+		}
+	}
+	return holidays
+}
+
+// LoadHolidaysForSubdivisions returns the national holidays for year plus those defined
+// for any of the given subdivisions
+func (p *JSONProvider) LoadHolidaysForSubdivisions(year int, subdivisions []string) map[time.Time]*Holiday {
+	wanted := make(map[string]bool, len(subdivisions))
+	for _, code := range subdivisions {
+		wanted[code] = true
+	}
+
+	regional := make(map[time.Time]*Holiday)
+	for _, definition := range p.sortedDefinitions() {
+		applies := false
+		for _, code := range definition.Subdivisions {
+			applies = applies || wanted[code]
+		}
+		if !applies {
+			continue
+		}
+		if holiday := definition.holiday(year); holiday != nil {
+			if _, exists := regional[holiday.Date]; !exists {
+				regional[holiday.Date] = holiday
+			}
+		}
+	}
+	return mergeHolidays(p.LoadHolidays(year), regional)
+}
+
+// Capabilities returns the features supported by the provider
+func (p *JSONProvider) Capabilities() ProviderCapabilities {
+	capabilities := p.BaseProvider.Capabilities()
+	capabilities.HistoricalRanges = false
+	for _, definition := range p.data.Holidays {
+		if definition.YearRange != nil {
+			capabilities.HistoricalRanges = true
+		}
+		if definition.Calculation == "easter_based" && definition.EasterOffset == 0 {
+			capabilities.EasterSunday = true
+		}
+	}
+	return capabilities
+}
+
+// sortedDefinitions returns the definitions ordered by key, so that definitions sharing
+// a date resolve the same way on every load
+func (p *JSONProvider) sortedDefinitions() []HolidayDefinition {
+	keys := make([]string, 0, len(p.data.Holidays))
+	for key := range p.data.Holidays {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	definitions := make([]HolidayDefinition, 0, len(keys))
+	for _, key := range keys {
+		definitions = append(definitions, p.data.Holidays[key])
+	}
+	return definitions
+}
+
+// DateForYear evaluates the definition for the given year. It returns false
+// when the definition does not apply to that year or cannot be evaluated, including
+// a date the month lacks that year, such as February 29 or a fifth Monday.
+func (hd HolidayDefinition) DateForYear(year int) (time.Time, bool) {
+	if hd.YearRange != nil {
+		if hd.YearRange.Start != 0 && year < hd.YearRange.Start {
+			return time.Time{}, false
+		}
+		if hd.YearRange.End != 0 && year > hd.YearRange.End {
+			return time.Time{}, false
+		}
+	}
+
+	switch hd.Calculation {
+	case "fixed":
+		if hd.Month < 1 || hd.Month > 12 || hd.Day < 1 {
+			return time.Time{}, false
+		}
+		date := time.Date(year, time.Month(hd.Month), hd.Day, 0, 0, 0, 0, time.UTC)
+		return date, date.Day() == hd.Day
+	case "easter_based":
+		return EasterSunday(year).AddDate(0, 0, hd.EasterOffset), true
+	case "weekday_based":
+		if hd.WeekdayRule == nil || hd.WeekdayRule.Occurrence == 0 {
+			return time.Time{}, false
+		}
+		month := time.Month(hd.WeekdayRule.Month)
+		date := NthWeekdayOfMonth(year, month, hd.WeekdayRule.Weekday, hd.WeekdayRule.Occurrence)
+		return date, !date.IsZero() && date.Month() == month
+	default:
+		return time.Time{}, false
+	}
+}
+
+// holiday evaluates the definition for year, or returns nil when it does not occur
+func (hd HolidayDefinition) holiday(year int) *Holiday {
+	date, ok := hd.DateForYear(year)
+	if !ok {
+		return nil
+	}
+
+	category := hd.Category
+	if category == "" {
+		category = "public"
+	}
+	languages := hd.Languages
+	if len(languages) == 0 {
+		languages = map[string]string{"en": hd.Name}
+	}
+
+	holiday := &Holiday{
+		Name:         hd.Name,
+		Date:         date,
+		Category:     category,
+		Languages:    languages,
+		Subdivisions: hd.Subdivisions,
+	}
+	if hd.YearRange != nil {
+		holiday.FromYear = hd.YearRange.Start
+		holiday.ToYear = hd.YearRange.End
+	}
+	return holiday
+}
@@ -0,0 +1,93 @@
+package countries
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+// testCountryData describes a fictional country in the format written by the sync tool
+const testCountryData = `{
+  "country_code": "ZZ",
+  "name": "Testland",
+  "subdivisions": {"N": "North", "S": "South"},
+  "categories": ["public", "religious"],
+  "languages": ["en"],
+  "holidays": {
+    "new_years_day": {"name": "New Year's Day", "category": "public", "calculation": "fixed", "month": 1, "day": 1},
+    "leap_day": {"name": "Leap Day", "category": "public", "calculation": "fixed", "month": 2, "day": 29},
+    "good_friday": {"name": "Good Friday", "category": "religious", "calculation": "easter_based", "easter_offset": -2},
+    "labor_day": {"name": "Labor Day", "category": "public", "calculation": "weekday_based",
+      "weekday_rule": {"month": 9, "weekday": 1, "occurrence": 1}},
+    "republic_day": {"name": "Republic Day", "category": "public", "calculation": "fixed", "month": 6, "day": 2,
+      "year_range": {"start": 2000}},
+    "north_day": {"name": "North Day", "category": "public", "calculation": "fixed", "month": 3, "day": 15,
+      "subdivisions": ["N"]}
+  }
+}`
+
+func newTestJSONProvider(t *testing.T) *JSONProvider {
+	t.Helper()
+
+	var data CountryData
+	if err := json.Unmarshal([]byte(testCountryData), &data); err != nil {
+		t.Fatalf("Failed to decode country data: %v", err)
+	}
+	return NewJSONProvider(data)
+}
+
+func TestJSONProvider(t *testing.T) {
+	provider := newTestJSONProvider(t)
+
+	if provider.GetCountryCode() != "ZZ" {
+		t.Errorf("Expected country code ZZ, got %s", provider.GetCountryCode())
+	}
+	if subdivisions := provider.GetSupportedSubdivisions(); len(subdivisions) != 2 || subdivisions[0] != "N" {
+		t.Errorf("Expected subdivisions [N S], got %v", subdivisions)
+	}
+
+	holidays := provider.LoadHolidays(2024)
+	expected := map[time.Time]string{
+		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC):  "New Year's Day",
+		time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC): "Leap Day",
+		time.Date(2024, 3, 29, 0, 0, 0, 0, time.UTC): "Good Friday",
+		time.Date(2024, 9, 2, 0, 0, 0, 0, time.UTC):  "Labor Day",
+		time.Date(2024, 6, 2, 0, 0, 0, 0, time.UTC):  "Republic Day",
+	}
+	if len(holidays) != len(expected) {
+		t.Errorf("Expected %d national holidays in 2024, got %d", len(expected), len(holidays))
+	}
+	for date, name := range expected {
+		if holiday, ok := holidays[date]; !ok || holiday.Name != name {
+			t.Errorf("Expected %s on %s, got %v", name, date.Format("2006-01-02"), holiday)
+		}
+	}
+	if holiday := holidays[time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)]; holiday.Observed != nil || holiday.Languages["en"] != "New Year's Day" {
+		t.Errorf("Expected New Year's Day without an observed date and with an English name, got %+v", holiday)
+	}
+
+	// Leap Day only occurs in leap years and Republic Day only from 2000
+	if _, ok := provider.LoadHolidays(2023)[time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)]; ok {
+		t.Error("Expected Leap Day not to roll over to March 1 in 2023")
+	}
+	if got := len(provider.LoadHolidays(1999)); got != 3 {
+		t.Errorf("Expected 3 holidays in 1999, got %d", got)
+	}
+}
+
+func TestJSONProviderSubdivisions(t *testing.T) {
+	provider := newTestJSONProvider(t)
+	northDay := time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)
+
+	if _, ok := provider.LoadHolidays(2024)[northDay]; ok {
+		t.Error("Expected North Day to be left out of the national holidays")
+	}
+
+	north := provider.LoadHolidaysForSubdivisions(2024, []string{"N"})
+	if holiday, ok := north[northDay]; !ok || len(holiday.Subdivisions) != 1 || holiday.Subdivisions[0] != "N" {
+		t.Errorf("Expected North Day in subdivision N, got %v", holiday)
+	}
+	if _, ok := provider.LoadHolidaysForSubdivisions(2024, []string{"S"})[northDay]; ok {
+		t.Error("Expected North Day to be left out of subdivision S")
+	}
+}
@@ -124,11 +124,10 @@ func (kr *KRProvider) LoadHolidays(year int) map[time.Time]*Holiday {
 		},
 	)
 
-	// Buddha's Birthday - varies each year (8th day of 4th lunar month)
-	// Approximation: typically in May
-	// For 2024, Buddha's Birthday is May 15
-	if year == 2024 {
-		buddha := time.Date(2024, 5, 15, 0, 0, 0, 0, time.UTC)
+	// Buddha's Birthday - 8th day of the 4th lunar month
+	// Lunar holidays can fall on a fixed one, such as Children's Day in 2025, which then keeps the date
+	buddha := KoreanLunarDate(year, LunarFourthMonth, 8)
+	if _, taken := holidays[buddha]; !taken {
 		holidays[buddha] = kr.CreateHoliday(
 			"부처님 오신 날",
 			buddha,
@@ -140,83 +139,47 @@ func (kr *KRProvider) LoadHolidays(year int) map[time.Time]*Holiday {
 		)
 	}
 
-	// Lunar New Year (Seollal) - varies each year
-	// For 2024, Lunar New Year is February 10-12
-	if year == 2024 {
-		// Seollal spans 3 days
-		seollal1 := time.Date(2024, 2, 9, 0, 0, 0, 0, time.UTC)
-		holidays[seollal1] = kr.CreateHoliday(
-			"설날 연휴",
-			seollal1,
-			"traditional",
-			map[string]string{
-				"ko": "설날 연휴",
-				"en": "Lunar New Year Holiday",
-			},
-		)
+	// Lunar New Year (Seollal) - 1st day of the 1st lunar month, with the day before and after
+	kr.addLunarHolidays(holidays, KoreanLunarDate(year, LunarFirstMonth, 1),
+		"설날", "Lunar New Year", "설날 연휴", "Lunar New Year Holiday")
 
-		seollal2 := time.Date(2024, 2, 10, 0, 0, 0, 0, time.UTC)
-		holidays[seollal2] = kr.CreateHoliday(
-			"설날",
-			seollal2,
-			"traditional",
-			map[string]string{
-				"ko": "설날",
-				"en": "Lunar New Year",
-			},
-		)
+	// Chuseok (Korean Thanksgiving) - 15th day of the 8th lunar month, with the day before and after
+	kr.addLunarHolidays(holidays, KoreanLunarDate(year, LunarEighthMonth, 15),
+		"추석", "Chuseok", "추석 연휴", "Chuseok Holiday")
 
-		seollal3 := time.Date(2024, 2, 11, 0, 0, 0, 0, time.UTC)
-		holidays[seollal3] = kr.CreateHoliday(
-			"설날 연휴",
-			seollal3,
-			"traditional",
-			map[string]string{
-				"ko": "설날 연휴",
-				"en": "Lunar New Year Holiday",
-			},
-		)
-	}
-
-	// Chuseok (Korean Thanksgiving) - varies each year
-	// For 2024, Chuseok is September 16-18
-	if year == 2024 {
-		// Chuseok spans 3 days
-		chuseok1 := time.Date(2024, 9, 16, 0, 0, 0, 0, time.UTC)
-		holidays[chuseok1] = kr.CreateHoliday(
-			"추석 연휴",
-			chuseok1,
-			"traditional",
-			map[string]string{
-				"ko": "추석 연휴",
-				"en": "Chuseok Holiday",
-			},
-		)
+	return holidays
+}
 
-		chuseok2 := time.Date(2024, 9, 17, 0, 0, 0, 0, time.UTC)
-		holidays[chuseok2] = kr.CreateHoliday(
-			"추석",
-			chuseok2,
+// addLunarHolidays adds a three-day lunar holiday: the main day and the day before and
+// after. Days already taken by a fixed holiday, such as National Foundation Day during
+// Chuseok in 2028, keep that holiday.
+func (kr *KRProvider) addLunarHolidays(holidays map[time.Time]*Holiday, date time.Time, name, enName, holidayName, enHolidayName string) {
+	if _, taken := holidays[date]; !taken {
+		holidays[date] = kr.CreateHoliday(
+			name,
+			date,
 			"traditional",
 			map[string]string{
-				"ko": "추석",
-				"en": "Chuseok",
+				"ko": name,
+				"en": enName,
 			},
 		)
+	}
 
-		chuseok3 := time.Date(2024, 9, 18, 0, 0, 0, 0, time.UTC)
-		holidays[chuseok3] = kr.CreateHoliday(
-			"추석 연휴",
-			chuseok3,
+	for _, day := range []time.Time{date.AddDate(0, 0, -1), date.AddDate(0, 0, 1)} {
+		if _, taken := holidays[day]; taken {
+			continue
+		}
+		holidays[day] = kr.CreateHoliday(
+			holidayName,
+			day,
 			"traditional",
 			map[string]string{
-				"ko": "추석 연휴",
-				"en": "Chuseok Holiday",
+				"ko": holidayName,
+				"en": enHolidayName,
 			},
 		)
 	}
-
-	return holidays
 }
 
 // CreateHoliday creates a new holiday with Korean localization
@@ -172,3 +172,33 @@ func BenchmarkKRProvider(b *testing.B) {
 		_ = provider.LoadHolidays(2024)
 	}
 }
+
+func TestKRLunarHolidays2025(t *testing.T) {
+	provider := NewKRProvider()
+	holidays := provider.LoadHolidays(2025)
+
+	testCases := []struct {
+		date time.Time
+		name string
+	}{
+		{time.Date(2025, 1, 28, 0, 0, 0, 0, time.UTC), "설날 연휴"},
+		{time.Date(2025, 1, 29, 0, 0, 0, 0, time.UTC), "설날"},
+		{time.Date(2025, 1, 30, 0, 0, 0, 0, time.UTC), "설날 연휴"},
+		{time.Date(2025, 10, 5, 0, 0, 0, 0, time.UTC), "추석 연휴"},
+		{time.Date(2025, 10, 6, 0, 0, 0, 0, time.UTC), "추석"},
+		{time.Date(2025, 10, 7, 0, 0, 0, 0, time.UTC), "추석 연휴"},
+		// Buddha's Birthday falls on Children's Day, which keeps the date
+		{time.Date(2025, 5, 5, 0, 0, 0, 0, time.UTC), "어린이날"},
+	}
+
+	for _, tc := range testCases {
+		holiday, exists := holidays[tc.date]
+		if !exists {
+			t.Errorf("Expected holiday on %s, but none found", tc.date.Format("2006-01-02"))
+			continue
+		}
+		if holiday.Name != tc.name {
+			t.Errorf("Expected holiday name '%s' on %s, got '%s'", tc.name, tc.date.Format("2006-01-02"), holiday.Name)
+		}
+	}
+}
@@ -0,0 +1,275 @@
+package countries
+
+import (
+	"math"
+	"time"
+)
+
+// This file computes dates in the Chinese lunisolar calendar and its Korean counterpart.
+// Each month starts on the civil day of a new moon. The month containing the winter
+// solstice is the 11th; when 13 new moons begin between one 11th month and the next,
+// the first of those months without a major solar term (a multiple of 30 degrees of
+// solar longitude) is intercalary and repeats the number of the month before it. China
+// reckons days at UTC+8, while Korea uses its standard time, so the two calendars
+// occasionally start a month a day apart, as for Seollal in 1997.
+//
+// New moons follow Meeus, "Astronomical Algorithms", chapter 49, which is accurate to
+// well under a minute. Solar terms use the low-precision solar position, within about
+// fifteen minutes, which only matters for a term falling next to a new moon at midnight.
+
+// Lunar months used for holidays
+const (
+	LunarFirstMonth  = 1
+	LunarFourthMonth = 4
+	LunarFifthMonth  = 5
+	LunarEighthMonth = 8
+)
+
+const (
+	lunisolarNewMoonEpoch = 2451550.09766 // Julian ephemeris day of the new moon of 6 January 2000
+	tropicalYear          = 365.24219     // mean days between solstices
+	koreaMeridianStart    = 2434822.5     // Julian day of 21 March 1954, when Korea moved to UTC+8:30
+	koreaMeridianEnd      = 2437520.5     // Julian day of 10 August 1961, when Korea returned to UTC+9
+)
+
+// lunisolarOffset returns a calendar's offset from UTC, as a fraction of a day, at jd
+type lunisolarOffset func(jd float64) float64
+
+// chinaOffset is the offset of the Chinese calendar, which uses the 120°E meridian
+func chinaOffset(float64) float64 {
+	return 8.0 / 24
+}
+
+// koreaOffset is the offset of the Korean calendar, which follows Korean standard time
+func koreaOffset(jd float64) float64 {
+	if jd >= koreaMeridianStart && jd < koreaMeridianEnd {
+		return 8.5 / 24
+	}
+	return 9.0 / 24
+}
+
+// lunisolarMonth is a month starting on the local day number of its new moon
+type lunisolarMonth struct {
+	start  int
+	number int
+	leap   bool
+}
+
+// ChineseLunarDate returns the Gregorian date of day of the given regular (non-leap)
+// month in the Chinese lunar year beginning in year, or the zero time if the month is
+// not between 1 and 12
+func ChineseLunarDate(year, month, day int) time.Time {
+	return lunisolarDate(year, month, day, chinaOffset)
+}
+
+// KoreanLunarDate returns the Gregorian date of day of the given regular (non-leap)
+// month in the Korean lunar year beginning in year, or the zero time if the month is
+// not between 1 and 12
+func KoreanLunarDate(year, month, day int) time.Time {
+	return lunisolarDate(year, month, day, koreaOffset)
+}
+
+// LunarNewYear returns the first day of the Chinese lunar year beginning in year
+func LunarNewYear(year int) time.Time {
+	return ChineseLunarDate(year, LunarFirstMonth, 1)
+}
+
+// MidAutumnDate returns the Mid-Autumn Festival, the 15th day of the 8th lunar month
+func MidAutumnDate(year int) time.Time {
+	return ChineseLunarDate(year, LunarEighthMonth, 15)
+}
+
+// lunisolarDate returns the Gregorian date of a lunar date in the calendar kept at offset
+func lunisolarDate(year, month, day int, offset lunisolarOffset) time.Time {
+	if month < 1 || month > 12 {
+		return time.Time{}
+	}
+
+	// The 11th and 12th months fall after the solstice that ends the year's own run
+	sui := year
+	if month >= 11 {
+		sui = year + 1
+	}
+	for _, m := range lunisolarMonths(sui, offset) {
+		if m.number == month && !m.leap {
+			return dayNumberDate(m.start + day - 1)
+		}
+	}
+	return time.Time{}
+}
+
+// lunisolarMonths returns the months from the 11th month of the previous lunar year,
+// containing the winter solstice of year-1, up to the 11th month containing the one of
+// year
+func lunisolarMonths(year int, offset lunisolarOffset) []lunisolarMonth {
+	solstice := solarTermTime(270, julianDay(time.Date(year-1, time.December, 21, 0, 0, 0, 0, time.UTC)))
+	nextSolstice := solarTermTime(270, solstice+tropicalYear)
+	first := newMoonOnOrBefore(localDayNumber(solstice, offset), offset)
+	last := newMoonOnOrBefore(localDayNumber(nextSolstice, offset), offset)
+
+	// The local days of the major solar terms from one solstice to the next
+	var terms []int
+	for i := 0; i <= 12; i++ {
+		jd := solarTermTime(normalizeDegrees(270+30*float64(i)), solstice+float64(i)*tropicalYear/12)
+		terms = append(terms, localDayNumber(jd, offset))
+	}
+
+	leapYear := last-first == 13
+	months := make([]lunisolarMonth, 0, last-first)
+	number := 11
+	for k := first; k < last; k++ {
+		start := localDayNumber(newMoon(k), offset)
+		end := localDayNumber(newMoon(k+1), offset)
+
+		month := lunisolarMonth{start: start}
+		if k > first {
+			if leapYear && !hasTermBetween(terms, start, end) {
+				month.leap = true
+				leapYear = false
+			} else {
+				number = number%12 + 1
+			}
+		}
+		month.number = number
+		months = append(months, month)
+	}
+	return months
+}
+
+// hasTermBetween reports whether any of the term days falls in [start, end)
+func hasTermBetween(terms []int, start, end int) bool {
+	for _, day := range terms {
+		if day >= start && day < end {
+			return true
+		}
+	}
+	return false
+}
+
+// newMoonOnOrBefore returns the index of the last new moon starting a month on or before
+// the local day number day
+func newMoonOnOrBefore(day int, offset lunisolarOffset) int {
+	k := int(math.Floor((float64(day) - lunisolarNewMoonEpoch) / synodicMonth))
+	for localDayNumber(newMoon(k), offset) > day {
+		k--
+	}
+	for localDayNumber(newMoon(k+1), offset) <= day {
+		k++
+	}
+	return k
+}
+
+// newMoon returns the Julian day (UT) of the kth new moon after that of 6 January 2000
+func newMoon(k int) float64 {
+	kf := float64(k)
+	t := kf / 1236.85
+	jde := lunisolarNewMoonEpoch + synodicMonth*kf + 0.00015437*t*t - 0.000000150*t*t*t + 0.00000000073*t*t*t*t
+
+	e := 1 - 0.002516*t - 0.0000074*t*t
+	m := radians(2.5534 + 29.10535670*kf - 0.0000014*t*t - 0.00000011*t*t*t)
+	mp := radians(201.5643 + 385.81693528*kf + 0.0107582*t*t + 0.00001238*t*t*t - 0.000000058*t*t*t*t)
+	f := radians(160.7108 + 390.67050284*kf - 0.0016118*t*t - 0.00000227*t*t*t + 0.000000011*t*t*t*t)
+	omega := radians(124.7746 - 1.56375588*kf + 0.0020672*t*t + 0.00000215*t*t*t)
+
+	jde += -0.40720*math.Sin(mp) +
+		0.17241*e*math.Sin(m) +
+		0.01608*math.Sin(2*mp) +
+		0.01039*math.Sin(2*f) +
+		0.00739*e*math.Sin(mp-m) -
+		0.00514*e*math.Sin(mp+m) +
+		0.00208*e*e*math.Sin(2*m) -
+		0.00111*math.Sin(mp-2*f) -
+		0.00057*math.Sin(mp+2*f) +
+		0.00056*e*math.Sin(2*mp+m) -
+		0.00042*math.Sin(3*mp) +
+		0.00042*e*math.Sin(m+2*f) +
+		0.00038*e*math.Sin(m-2*f) -
+		0.00024*e*math.Sin(2*mp-m) -
+		0.00017*math.Sin(omega) -
+		0.00007*math.Sin(mp+2*m) +
+		0.00004*math.Sin(2*mp-2*f) +
+		0.00004*math.Sin(3*m) +
+		0.00003*math.Sin(mp+m-2*f) +
+		0.00003*math.Sin(2*mp+2*f) -
+		0.00003*math.Sin(mp+m+2*f) +
+		0.00003*math.Sin(mp-m+2*f) -
+		0.00002*math.Sin(mp-m-2*f) -
+		0.00002*math.Sin(3*mp+m) +
+		0.00002*math.Sin(4*mp)
+
+	// Planetary perturbations, as angle and amplitude in millionths of a day
+	planetary := [][3]float64{
+		{299.77, 0.107408, 325}, {251.88, 0.016321, 165}, {251.83, 26.651886, 164},
+		{349.42, 36.412478, 126}, {84.66, 18.206239, 110}, {141.74, 53.303771, 62},
+		{207.14, 2.453732, 60}, {154.84, 7.306860, 56}, {34.52, 27.261239, 47},
+		{207.19, 0.121824, 42}, {291.34, 1.844379, 40}, {161.72, 24.198154, 37},
+		{239.56, 25.513099, 35}, {331.55, 3.592518, 23},
+	}
+	for i, term := range planetary {
+		angle := term[0] + term[1]*kf
+		if i == 0 {
+			angle -= 0.009173 * t * t
+		}
+		jde += term[2] / 1e6 * math.Sin(radians(angle))
+	}
+
+	return jde - deltaT(jde)/86400
+}
+
+// solarTermTime returns the Julian day (UT) near guess when the Sun's apparent longitude
+// reaches target degrees
+func solarTermTime(target, guess float64) float64 {
+	jd := guess
+	for i := 0; i < 20; i++ {
+		delta := normalizeDegrees(target-apparentSunLongitude(jd)+180) - 180
+		jd += delta * tropicalYear / 360
+		if math.Abs(delta) < 1e-6 {
+			break
+		}
+	}
+	return jd
+}
+
+// apparentSunLongitude returns the Sun's apparent ecliptic longitude in degrees at jd
+// (UT), corrected for aberration and nutation
+func apparentSunLongitude(jd float64) float64 {
+	jde := jd + deltaT(jd)/86400
+	t := (jde - julianDayJ2000) / 36525
+	omega := radians(125.04 - 1934.136*t)
+	return normalizeDegrees(sunLongitude(jde) - 0.00569 - 0.00478*math.Sin(omega))
+}
+
+// deltaT returns the difference between terrestrial and universal time in seconds at jd,
+// using the Espenak and Meeus polynomials for 1941-2150
+func deltaT(jd float64) float64 {
+	y := 2000 + (jd-julianDayJ2000)/365.25
+	switch {
+	case y < 1961:
+		t := y - 1950
+		return 29.07 + 0.407*t - t*t/233 + t*t*t/2547
+	case y < 1986:
+		t := y - 1975
+		return 45.45 + 1.067*t - t*t/260 - t*t*t/718
+	case y < 2005:
+		t := y - 2000
+		return 63.86 + 0.3345*t - 0.060374*t*t + 0.0017275*t*t*t + 0.000651814*t*t*t*t + 0.00002373599*t*t*t*t*t
+	case y < 2050:
+		t := y - 2000
+		return 62.92 + 0.32217*t + 0.005589*t*t
+	default:
+		u := (y - 1820) / 100
+		return -20 + 32*u*u - 0.5628*(2150-y)
+	}
+}
+
+// localDayNumber returns the Julian day number of the civil day containing jd in the
+// calendar kept at offset
+func localDayNumber(jd float64, offset lunisolarOffset) int {
+	return int(math.Floor(jd + 0.5 + offset(jd)))
+}
+
+// dayNumberDate converts a Julian day number to a date at midnight UTC
+func dayNumberDate(day int) time.Time {
+	date := fromJulianDay(float64(day) - 0.5)
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+}
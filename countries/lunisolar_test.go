@@ -0,0 +1,89 @@
+package countries
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLunarNewYear(t *testing.T) {
+	// Chinese New Year, as month and day, for 1950-2050
+	expected := [][2]int{
+		{2, 17}, {2, 6}, {1, 27}, {2, 14}, {2, 3}, {1, 24}, {2, 12}, {1, 31}, {2, 18}, {2, 8},
+		{1, 28}, {2, 15}, {2, 5}, {1, 25}, {2, 13}, {2, 2}, {1, 21}, {2, 9}, {1, 30}, {2, 17},
+		{2, 6}, {1, 27}, {2, 15}, {2, 3}, {1, 23}, {2, 11}, {1, 31}, {2, 18}, {2, 7}, {1, 28},
+		{2, 16}, {2, 5}, {1, 25}, {2, 13}, {2, 2}, {2, 20}, {2, 9}, {1, 29}, {2, 17}, {2, 6},
+		{1, 27}, {2, 15}, {2, 4}, {1, 23}, {2, 10}, {1, 31}, {2, 19}, {2, 7}, {1, 28}, {2, 16},
+		{2, 5}, {1, 24}, {2, 12}, {2, 1}, {1, 22}, {2, 9}, {1, 29}, {2, 18}, {2, 7}, {1, 26},
+		{2, 14}, {2, 3}, {1, 23}, {2, 10}, {1, 31}, {2, 19}, {2, 8}, {1, 28}, {2, 16}, {2, 5},
+		{1, 25}, {2, 12}, {2, 1}, {1, 22}, {2, 10}, {1, 29}, {2, 17}, {2, 6}, {1, 26}, {2, 13},
+		{2, 3}, {1, 23}, {2, 11}, {1, 31}, {2, 19}, {2, 8}, {1, 28}, {2, 15}, {2, 4}, {1, 24},
+		{2, 12}, {2, 1}, {1, 22}, {2, 10}, {1, 30}, {2, 17}, {2, 6}, {1, 26}, {2, 14}, {2, 2},
+		{1, 23},
+	}
+
+	for i, md := range expected {
+		year := 1950 + i
+		want := time.Date(year, time.Month(md[0]), md[1], 0, 0, 0, 0, time.UTC)
+		if got := LunarNewYear(year); !got.Equal(want) {
+			t.Errorf("LunarNewYear(%d) = %s, expected %s", year, got.Format("2006-01-02"), want.Format("2006-01-02"))
+		}
+	}
+}
+
+func TestChineseLunarDate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		got      time.Time
+		expected time.Time
+	}{
+		{"Mid-Autumn 2023", MidAutumnDate(2023), time.Date(2023, 9, 29, 0, 0, 0, 0, time.UTC)},
+		{"Mid-Autumn 2024", MidAutumnDate(2024), time.Date(2024, 9, 17, 0, 0, 0, 0, time.UTC)},
+		{"Mid-Autumn 2025", MidAutumnDate(2025), time.Date(2025, 10, 6, 0, 0, 0, 0, time.UTC)},
+		{"Mid-Autumn 2028", MidAutumnDate(2028), time.Date(2028, 10, 3, 0, 0, 0, 0, time.UTC)},
+		{"Dragon Boat 2023", ChineseLunarDate(2023, LunarFifthMonth, 5), time.Date(2023, 6, 22, 0, 0, 0, 0, time.UTC)},
+		{"Dragon Boat 2025", ChineseLunarDate(2025, LunarFifthMonth, 5), time.Date(2025, 5, 31, 0, 0, 0, 0, time.UTC)},
+		{"Dragon Boat 2030", ChineseLunarDate(2030, LunarFifthMonth, 5), time.Date(2030, 6, 5, 0, 0, 0, 0, time.UTC)},
+		// 2023 has a leap 2nd month, so the 3rd month starts a lunation later
+		{"3rd month 2023", ChineseLunarDate(2023, 3, 1), time.Date(2023, 4, 20, 0, 0, 0, 0, time.UTC)},
+		// 2033 has a leap 11th month, which delays the following new year
+		{"12th month 2033", ChineseLunarDate(2033, 12, 1), time.Date(2034, 1, 20, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tc := range testCases {
+		if !tc.got.Equal(tc.expected) {
+			t.Errorf("%s: got %s, expected %s", tc.name, tc.got.Format("2006-01-02"), tc.expected.Format("2006-01-02"))
+		}
+	}
+
+	if date := ChineseLunarDate(2024, 13, 1); !date.IsZero() {
+		t.Errorf("Expected the zero time for month 13, got %s", date)
+	}
+}
+
+func TestKoreanLunarDate(t *testing.T) {
+	// A new moon shortly before midnight in China falls after midnight in Korea
+	testCases := []struct {
+		year    int
+		korean  time.Time
+		chinese time.Time
+	}{
+		{1997, time.Date(1997, 2, 8, 0, 0, 0, 0, time.UTC), time.Date(1997, 2, 7, 0, 0, 0, 0, time.UTC)},
+		{2027, time.Date(2027, 2, 7, 0, 0, 0, 0, time.UTC), time.Date(2027, 2, 6, 0, 0, 0, 0, time.UTC)},
+		{2028, time.Date(2028, 1, 27, 0, 0, 0, 0, time.UTC), time.Date(2028, 1, 26, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tc := range testCases {
+		if got := KoreanLunarDate(tc.year, LunarFirstMonth, 1); !got.Equal(tc.korean) {
+			t.Errorf("Seollal %d = %s, expected %s", tc.year, got.Format("2006-01-02"), tc.korean.Format("2006-01-02"))
+		}
+		if got := LunarNewYear(tc.year); !got.Equal(tc.chinese) {
+			t.Errorf("Chinese New Year %d = %s, expected %s", tc.year, got.Format("2006-01-02"), tc.chinese.Format("2006-01-02"))
+		}
+	}
+
+	// Otherwise the calendars agree
+	if seollal, newYear := KoreanLunarDate(2024, LunarFirstMonth, 1), LunarNewYear(2024); !seollal.Equal(newYear) {
+		t.Errorf("Expected Seollal and Chinese New Year 2024 to match, got %s and %s",
+			seollal.Format("2006-01-02"), newYear.Format("2006-01-02"))
+	}
+}
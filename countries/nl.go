@@ -17,6 +17,7 @@ func NewNLProvider() *NLProvider {
 		"DR", "FL", "FR", "GE", "GR", "LI", "NB", "NH", "OV", "UT", "ZE", "ZH",
 	}
 	base.categories = []string{"public", "national", "religious", "royal"}
+	base.easterSunday = true
 
 	return &NLProvider{BaseProvider: base}
 }
@@ -113,15 +114,17 @@ func (nl *NLProvider) LoadHolidays(year int) map[time.Time]*Holiday {
 	easterDate := nl.CalculateEaster(year)
 
 	// Easter Sunday (Pasen)
-	holidays[easterDate] = nl.CreateHoliday(
-		"Eerste Paasdag",
-		easterDate,
-		"religious",
-		map[string]string{
-			"nl": "Eerste Paasdag",
-			"en": "Easter Sunday",
-		},
-	)
+	if nl.ObservesEasterSunday() {
+		holidays[easterDate] = nl.CreateHoliday(
+			"Eerste Paasdag",
+			easterDate,
+			"religious",
+			map[string]string{
+				"nl": "Eerste Paasdag",
+				"en": "Easter Sunday",
+			},
+		)
+	}
 
 	// Easter Monday (Tweede Paasdag)
 	easterMonday := easterDate.AddDate(0, 0, 1)
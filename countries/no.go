@@ -29,6 +29,7 @@ func NewNOProvider() *NOProvider {
 	}
 
 	base.categories = []string{"national", "religious", "traditional", "royal"}
+	base.easterSunday = true
 
 	return &NOProvider{BaseProvider: base}
 }
@@ -68,13 +69,15 @@ func (no *NOProvider) LoadHolidays(year int) map[time.Time]*Holiday {
 	)
 
 	// Easter Sunday
-	holidays[easter] = no.CreateHoliday(
-		"Første påskedag", easter, "religious",
-		map[string]string{
-			"no": "Første påskedag",
-			"en": "Easter Sunday",
-		},
-	)
+	if no.ObservesEasterSunday() {
+		holidays[easter] = no.CreateHoliday(
+			"Første påskedag", easter, "religious",
+			map[string]string{
+				"no": "Første påskedag",
+				"en": "Easter Sunday",
+			},
+		)
+	}
 
 	// Easter Monday (day after Easter)
 	easterMonday := easter.AddDate(0, 0, 1)
@@ -166,9 +166,11 @@ func (nz *NZProvider) LoadHolidays(year int) map[time.Time]*Holiday {
 				"mi": "Matariki",
 			},
 		)
+		// Matariki has been a public holiday since 2022
+		holidays[matariki].FromYear = 2022
 	}
 
-	return holidays
+	return inEffect(holidays, year)
 }
 
 // GetRegionalHolidays returns region-specific holidays (provincial anniversaries)
@@ -447,3 +449,8 @@ func (nz *NZProvider) GetSeasons(year int) map[string][]time.Time {
 		},
 	}
 }
+
+// LoadHolidaysForSubdivisions returns the national holidays plus those of the given regions
+func (nz *NZProvider) LoadHolidaysForSubdivisions(year int, regions []string) map[time.Time]*Holiday {
+	return mergeHolidays(nz.LoadHolidays(year), nz.GetRegionalHolidays(year, regions))
+}
@@ -19,6 +19,7 @@ func NewPLProvider() *PLProvider {
 		// Greater Poland, West Pomeranian
 	}
 	base.categories = []string{"public", "religious", "national"}
+	base.easterSunday = true
 
 	return &PLProvider{BaseProvider: base}
 }
@@ -155,15 +156,17 @@ func (pl *PLProvider) LoadHolidays(year int) map[time.Time]*Holiday {
 	easter := EasterSunday(year)
 
 	// Easter Sunday
-	holidays[easter] = pl.CreateHoliday(
-		"Niedziela Wielkanocna",
-		easter,
-		"religious",
-		map[string]string{
-			"pl": "Niedziela Wielkanocna",
-			"en": "Easter Sunday",
-		},
-	)
+	if pl.ObservesEasterSunday() {
+		holidays[easter] = pl.CreateHoliday(
+			"Niedziela Wielkanocna",
+			easter,
+			"religious",
+			map[string]string{
+				"pl": "Niedziela Wielkanocna",
+				"en": "Easter Sunday",
+			},
+		)
+	}
 
 	// Easter Monday
 	easterMonday := easter.AddDate(0, 0, 1)
@@ -359,3 +362,8 @@ func (pl *PLProvider) GetSpecialObservances(year int) map[time.Time]*Holiday {
 
 	return holidays
 }
+
+// LoadHolidaysForSubdivisions returns the national holidays plus those of the given voivodeships
+func (pl *PLProvider) LoadHolidaysForSubdivisions(year int, voivodeships []string) map[time.Time]*Holiday {
+	return mergeHolidays(pl.LoadHolidays(year), pl.GetRegionalHolidays(year, voivodeships))
+}
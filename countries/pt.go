@@ -20,6 +20,7 @@ func NewPTProvider() *PTProvider {
 		"20", "30", // Azores, Madeira
 	}
 	base.categories = []string{"public", "religious", "regional", "municipal"}
+	base.easterSunday = true
 
 	return &PTProvider{BaseProvider: base}
 }
@@ -90,6 +91,9 @@ func (pt *PTProvider) addEasterHolidays(holidays map[time.Time]*Holiday, year in
 	}
 
 	for _, h := range easterHolidays {
+		if h.offset == 0 && !pt.ObservesEasterSunday() {
+			continue
+		}
 		date := easter.AddDate(0, 0, h.offset)
 		holidays[date] = &Holiday{
 			Name:     h.nameEn,
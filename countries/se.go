@@ -18,6 +18,7 @@ func NewSEProvider() *SEProvider {
 		"M", "N", "O", "S", "T", "U", "W", "X", "Y", "Z",
 	}
 	base.categories = []string{"public", "religious", "cultural", "traditional"}
+	base.easterSunday = true
 
 	return &SEProvider{BaseProvider: base}
 }
@@ -176,15 +177,17 @@ func (se *SEProvider) LoadHolidays(year int) map[time.Time]*Holiday {
 	)
 
 	// Easter Sunday
-	holidays[easterDate] = se.CreateHoliday(
-		"Påskdagen",
-		easterDate,
-		"religious",
-		map[string]string{
-			"sv": "Påskdagen",
-			"en": "Easter Sunday",
-		},
-	)
+	if se.ObservesEasterSunday() {
+		holidays[easterDate] = se.CreateHoliday(
+			"Påskdagen",
+			easterDate,
+			"religious",
+			map[string]string{
+				"sv": "Påskdagen",
+				"en": "Easter Sunday",
+			},
+		)
+	}
 
 	// Easter Monday
 	easterMonday := easterDate.AddDate(0, 0, 1)
@@ -0,0 +1,24 @@
+package countries
+
+import "time"
+
+// SubdivisionHolidayProvider is implemented by providers that have holidays observed only
+// in some of their subdivisions
+type SubdivisionHolidayProvider interface {
+	HolidayProvider
+	// LoadHolidaysForSubdivisions returns the national holidays for year plus those of the
+	// given subdivisions. Unknown subdivision codes are ignored, and an empty list yields
+	// only the national holidays.
+	LoadHolidaysForSubdivisions(year int, subdivisions []string) map[time.Time]*Holiday
+}
+
+// mergeHolidays adds the subdivision holidays to the national ones. A subdivision holiday
+// falling on the date of a national holiday does not replace it.
+func mergeHolidays(national, subdivision map[time.Time]*Holiday) map[time.Time]*Holiday {
+	for date, holiday := range subdivision {
+		if _, exists := national[date]; !exists {
+			national[date] = holiday
+		}
+	}
+	return national
+}
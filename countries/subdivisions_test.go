@@ -0,0 +1,29 @@
+package countries
+
+import "testing"
+
+func TestLoadHolidaysForSubdivisions(t *testing.T) {
+	providers := []SubdivisionHolidayProvider{
+		NewATProvider(), NewAUProvider(), NewBEProvider(), NewCAProvider(), NewCNProvider(),
+		NewDEProvider(), NewFRProvider(), NewGBProvider(), NewINProvider(), NewITProvider(),
+		NewNZProvider(), NewPLProvider(), NewUSProvider(),
+	}
+
+	for _, provider := range providers {
+		t.Run(provider.GetCountryCode(), func(t *testing.T) {
+			national := len(provider.LoadHolidays(2024))
+
+			if got := len(provider.LoadHolidaysForSubdivisions(2024, nil)); got != national {
+				t.Errorf("Expected %d national holidays without subdivisions, got %d", national, got)
+			}
+			if got := len(provider.LoadHolidaysForSubdivisions(2024, []string{"ZZ-UNKNOWN"})); got != national {
+				t.Errorf("Expected an unknown subdivision to be ignored, got %d holidays instead of %d", got, national)
+			}
+
+			all := provider.LoadHolidaysForSubdivisions(2024, provider.GetSupportedSubdivisions())
+			if len(all) < national {
+				t.Errorf("Expected at least the %d national holidays with all subdivisions, got %d", national, len(all))
+			}
+		})
+	}
+}
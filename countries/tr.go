@@ -1,6 +1,7 @@
 package countries
 
 import (
+	"fmt"
 	"time"
 )
 
@@ -125,106 +126,34 @@ func (p *TRProvider) addFixedHolidays(holidays map[time.Time]*Holiday, year int)
 	)
 }
 
-// addIslamicHolidays adds Islamic holidays based on lunar calendar
+// addIslamicHolidays adds the Ramadan and Sacrifice festivals, computed from the Hijri calendar
 func (p *TRProvider) addIslamicHolidays(holidays map[time.Time]*Holiday, year int) {
-	// Note: Islamic holidays are based on lunar calendar and shift each year
-	// These are approximations for common years. In practice, these would be
-	// calculated using precise astronomical calculations or official announcements.
-
-	// Ramadan Festival (Eid al-Fitr) - 3 days
-	ramadanFestival := p.getIslamicHolidayDate(year, "ramadan")
-	if !ramadanFestival.IsZero() {
-		for i := 0; i < 3; i++ {
-			date := ramadanFestival.AddDate(0, 0, i)
-			dayName := "Ramazan Bayramı"
-			enName := "Ramadan Festival"
-			if i == 0 {
-				dayName += " 1. Gün"
-				enName += " Day 1"
-			} else if i == 1 {
-				dayName += " 2. Gün"
-				enName += " Day 2"
-			} else {
-				dayName += " 3. Gün"
-				enName += " Day 3"
-			}
-
-			holidays[date] = p.CreateHoliday(
-				dayName,
-				date,
-				"religious",
-				map[string]string{
-					"tr": dayName,
-					"en": enName,
-				},
-			)
-		}
+	festivals := []struct {
+		month  int
+		day    int
+		days   int
+		name   string
+		nameEN string
+	}{
+		{HijriShawwal, 1, 3, "Ramazan Bayramı", "Ramadan Festival"},       // Eid al-Fitr
+		{HijriDhuAlHijjah, 10, 4, "Kurban Bayramı", "Sacrifice Festival"}, // Eid al-Adha
 	}
 
-	// Sacrifice Festival (Eid al-Adha) - 4 days
-	sacrificeFestival := p.getIslamicHolidayDate(year, "sacrifice")
-	if !sacrificeFestival.IsZero() {
-		for i := 0; i < 4; i++ {
-			date := sacrificeFestival.AddDate(0, 0, i)
-			dayName := "Kurban Bayramı"
-			enName := "Sacrifice Festival"
-			if i == 0 {
-				dayName += " 1. Gün"
-				enName += " Day 1"
-			} else if i == 1 {
-				dayName += " 2. Gün"
-				enName += " Day 2"
-			} else if i == 2 {
-				dayName += " 3. Gün"
-				enName += " Day 3"
-			} else {
-				dayName += " 4. Gün"
-				enName += " Day 4"
+	for _, festival := range festivals {
+		for i := 0; i < festival.days; i++ {
+			dayName := fmt.Sprintf("%s %d. Gün", festival.name, i+1)
+			enName := fmt.Sprintf("%s Day %d", festival.nameEN, i+1)
+			for _, date := range HijriDates(year, festival.month, festival.day+i, p.hijriOffset) {
+				holidays[date] = p.CreateHoliday(
+					dayName,
+					date,
+					"religious",
+					map[string]string{
+						"tr": dayName,
+						"en": enName,
+					},
+				)
 			}
-
-			holidays[date] = p.CreateHoliday(
-				dayName,
-				date,
-				"religious",
-				map[string]string{
-					"tr": dayName,
-					"en": enName,
-				},
-			)
-		}
-	}
-}
-
-// getIslamicHolidayDate returns approximate dates for Islamic holidays
-// In practice, these would be calculated using precise astronomical calculations
-func (p *TRProvider) getIslamicHolidayDate(year int, holiday string) time.Time {
-	// Approximate dates for major Islamic holidays
-	// These shift about 11 days earlier each year due to lunar calendar
-
-	islamicHolidays := map[int]map[string]time.Time{
-		2024: {
-			"ramadan":   time.Date(2024, 4, 10, 0, 0, 0, 0, time.UTC), // Eid al-Fitr
-			"sacrifice": time.Date(2024, 6, 16, 0, 0, 0, 0, time.UTC), // Eid al-Adha
-		},
-		2025: {
-			"ramadan":   time.Date(2025, 3, 30, 0, 0, 0, 0, time.UTC), // Eid al-Fitr
-			"sacrifice": time.Date(2025, 6, 6, 0, 0, 0, 0, time.UTC),  // Eid al-Adha
-		},
-		2026: {
-			"ramadan":   time.Date(2026, 3, 20, 0, 0, 0, 0, time.UTC), // Eid al-Fitr
-			"sacrifice": time.Date(2026, 5, 26, 0, 0, 0, 0, time.UTC), // Eid al-Adha
-		},
-		2027: {
-			"ramadan":   time.Date(2027, 3, 9, 0, 0, 0, 0, time.UTC),  // Eid al-Fitr
-			"sacrifice": time.Date(2027, 5, 16, 0, 0, 0, 0, time.UTC), // Eid al-Adha
-		},
-	}
-
-	if yearHolidays, exists := islamicHolidays[year]; exists {
-		if date, exists := yearHolidays[holiday]; exists {
-			return date
 		}
 	}
-
-	return time.Time{} // Return zero time if not found
 }
@@ -1,6 +1,7 @@
 package countries
 
 import (
+	"fmt"
 	"testing"
 	"time"
 )
@@ -188,6 +189,29 @@ func TestTRIslamicHolidays(t *testing.T) {
 	}
 }
 
+func TestTRIslamicHolidaysComputed(t *testing.T) {
+	provider := NewTRProvider()
+
+	// The festivals are computed for any year, not only a tabulated few
+	holidays := provider.LoadHolidays(2030)
+	ramadan := HijriToGregorian(1451, HijriShawwal, 1)
+	for i, name := range []string{"Ramazan Bayramı 1. Gün", "Ramazan Bayramı 2. Gün", "Ramazan Bayramı 3. Gün"} {
+		date := ramadan.AddDate(0, 0, i)
+		if holiday, ok := holidays[date]; !ok || holiday.Name != name {
+			t.Errorf("Expected %s on %s, got %v", name, date.Format("2006-01-02"), holiday)
+		}
+	}
+
+	// Kurban Bayramı 1445 ran from 16 to 19 June 2024
+	holidays = provider.LoadHolidays(2024)
+	for day := 16; day <= 19; day++ {
+		date := time.Date(2024, 6, day, 0, 0, 0, 0, time.UTC)
+		if holiday, ok := holidays[date]; !ok || holiday.Languages["en"] != fmt.Sprintf("Sacrifice Festival Day %d", day-15) {
+			t.Errorf("Expected Sacrifice Festival Day %d on %s, got %v", day-15, date.Format("2006-01-02"), holiday)
+		}
+	}
+}
+
 func TestTRNationalSovereigntyDay(t *testing.T) {
 	provider := NewTRProvider()
 	holidays := provider.LoadHolidays(2024)
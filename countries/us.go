@@ -41,18 +41,17 @@ func (us *USProvider) LoadHolidays(year int) map[time.Time]*Holiday {
 	)
 
 	// Juneteenth - June 19 (federal holiday since 2021)
-	if year >= 2021 {
-		juneteenth := time.Date(year, 6, 19, 0, 0, 0, 0, time.UTC)
-		holidays[juneteenth] = us.CreateHoliday(
-			"Juneteenth",
-			juneteenth,
-			"federal",
-			map[string]string{
-				"en": "Juneteenth",
-				"es": "Juneteenth",
-			},
-		)
-	}
+	juneteenth := time.Date(year, 6, 19, 0, 0, 0, 0, time.UTC)
+	holidays[juneteenth] = us.CreateHoliday(
+		"Juneteenth",
+		juneteenth,
+		"federal",
+		map[string]string{
+			"en": "Juneteenth",
+			"es": "Juneteenth",
+		},
+	)
+	holidays[juneteenth].FromYear = 2021
 
 	holidays[time.Date(year, 7, 4, 0, 0, 0, 0, time.UTC)] = us.CreateHoliday(
 		"Independence Day",
@@ -87,18 +86,17 @@ func (us *USProvider) LoadHolidays(year int) map[time.Time]*Holiday {
 	// Variable date holidays
 
 	// Martin Luther King Jr. Day - 3rd Monday in January (since 1983)
-	if year >= 1983 {
-		mlkDay := NthWeekdayOfMonth(year, 1, time.Monday, 3)
-		holidays[mlkDay] = us.CreateHoliday(
-			"Martin Luther King Jr. Day",
-			mlkDay,
-			"federal",
-			map[string]string{
-				"en": "Martin Luther King Jr. Day",
-				"es": "Día de Martin Luther King Jr.",
-			},
-		)
-	}
+	mlkDay := NthWeekdayOfMonth(year, 1, time.Monday, 3)
+	holidays[mlkDay] = us.CreateHoliday(
+		"Martin Luther King Jr. Day",
+		mlkDay,
+		"federal",
+		map[string]string{
+			"en": "Martin Luther King Jr. Day",
+			"es": "Día de Martin Luther King Jr.",
+		},
+	)
+	holidays[mlkDay].FromYear = 1983
 
 	// Presidents' Day - 3rd Monday in February
 	presidentsDay := NthWeekdayOfMonth(year, 2, time.Monday, 3)
@@ -160,7 +158,7 @@ func (us *USProvider) LoadHolidays(year int) map[time.Time]*Holiday {
 		},
 	)
 
-	return holidays
+	return inEffect(holidays, year)
 }
 
 // GetStateHolidays returns state-specific holidays for given subdivisions
@@ -214,3 +212,8 @@ func (us *USProvider) GetStateHolidays(year int, subdivisions []string) map[time
 
 	return holidays
 }
+
+// LoadHolidaysForSubdivisions returns the national holidays plus those of the given states
+func (us *USProvider) LoadHolidaysForSubdivisions(year int, states []string) map[time.Time]*Holiday {
+	return mergeHolidays(us.LoadHolidays(year), us.GetStateHolidays(year, states))
+}
@@ -0,0 +1,66 @@
+package goholidays
+
+import "time"
+
+// customEdit is a holiday added with AddCustomHoliday or, when holiday is nil, a date
+// cleared with RemoveHoliday
+type customEdit struct {
+	date    time.Time
+	holiday *Holiday
+}
+
+// AddCustomHoliday adds a holiday, such as a company-specific day off, on date. On a date
+// that is already a holiday it is kept alongside, for HolidaysOn. The loaded years are
+// dropped and reload with the holiday, so it goes through the same steps, such as the
+// observance rule, whether it was added before or after its year was first used.
+func (c *Country) AddCustomHoliday(date time.Time, h *Holiday) {
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	holiday := *h
+	holiday.Date = day
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.customEdits = append(c.customEdits, customEdit{date: day, holiday: &holiday})
+	c.resetYears()
+}
+
+// RemoveHoliday removes every holiday on date, including custom ones added before. The
+// date stays clear whenever the year is reloaded, until a custom holiday is added on it.
+// Like AddCustomHoliday, it drops the loaded years.
+func (c *Country) RemoveHoliday(date time.Time) {
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.customEdits = append(c.customEdits, customEdit{date: day})
+	c.resetYears()
+}
+
+// applyCustomEdits replays the custom additions and removals for a year being loaded
+// (caller must hold the write lock)
+func (c *Country) applyCustomEdits(year int) {
+	for _, edit := range c.customEdits {
+		if edit.date.Year() == year {
+			c.applyCustomEdit(edit)
+		}
+	}
+}
+
+// applyCustomEdit applies one addition or removal to its loaded year (caller must hold the write lock)
+func (c *Country) applyCustomEdit(edit customEdit) {
+	year := edit.date.Year()
+	if _, loaded := c.years[year]; !loaded {
+		return
+	}
+
+	if edit.holiday == nil {
+		delete(c.years[year], edit.date)
+		delete(c.extras[year], edit.date)
+		return
+	}
+
+	holiday := *edit.holiday
+	c.addHoliday(year, &holiday)
+}
@@ -0,0 +1,127 @@
+package goholidays
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestAddCustomHoliday(t *testing.T) {
+	us := NewCountry("US")
+
+	// 2030 has not been loaded yet, so it loads with the holiday on first use
+	foundersDay := time.Date(2030, 3, 15, 9, 30, 0, 0, time.UTC)
+	custom := &Holiday{Name: "Founders Day", Category: CategoryPublic}
+	us.AddCustomHoliday(foundersDay, custom)
+
+	holidays := us.HolidaysForYear(2030)
+	day := time.Date(2030, 3, 15, 0, 0, 0, 0, time.UTC)
+	if h, ok := holidays[day]; !ok || h.Name != "Founders Day" || !h.Date.Equal(day) {
+		t.Fatalf("Expected Founders Day on %s, got %v", day.Format("2006-01-02"), h)
+	}
+	if _, ok := holidays[time.Date(2030, 7, 4, 0, 0, 0, 0, time.UTC)]; !ok {
+		t.Error("Expected the provider's holidays to be loaded alongside the custom one")
+	}
+	if !custom.Date.IsZero() {
+		t.Error("AddCustomHoliday should not modify the caller's holiday")
+	}
+
+	// On an existing holiday the custom one is kept alongside it
+	christmas := time.Date(2030, 12, 25, 0, 0, 0, 0, time.UTC)
+	us.AddCustomHoliday(christmas, &Holiday{Name: "Company Party", Category: CategoryPublic})
+	if h, _ := us.IsHoliday(christmas); h == nil || h.Name != "Christmas Day" {
+		t.Errorf("Expected Christmas Day to remain the primary holiday, got %v", h)
+	}
+	if on := us.HolidaysOn(christmas); len(on) != 2 || on[1].Name != "Company Party" {
+		t.Errorf("Expected Christmas Day and Company Party, got %v", on)
+	}
+
+	// Custom holidays survive the cache being reset
+	us.SetObservanceRule(ObservanceRule{Shift: ObservanceNearestWeekday})
+	if h, ok := us.IsHoliday(day); !ok || h.Name != "Founders Day" {
+		t.Errorf("Expected Founders Day after a reload, got %v", h)
+	}
+}
+
+func TestRemoveHoliday(t *testing.T) {
+	us := NewCountry("US")
+	independenceDay := time.Date(2024, 7, 4, 0, 0, 0, 0, time.UTC)
+
+	us.RemoveHoliday(independenceDay)
+	if h, ok := us.IsHoliday(independenceDay); ok {
+		t.Errorf("Expected no holiday after RemoveHoliday, got %v", h)
+	}
+	if _, ok := us.HolidaysForYear(2024)[independenceDay]; ok {
+		t.Error("Expected HolidaysForYear to omit the removed holiday")
+	}
+
+	us.SetObservanceRule(ObservanceRule{Shift: ObservanceFollowingWeekday})
+	if _, ok := us.IsHoliday(independenceDay); ok {
+		t.Error("Expected the removal to survive a reload")
+	}
+
+	// Edits apply in order, so a later addition fills the removed date
+	us.AddCustomHoliday(independenceDay, &Holiday{Name: "Company Picnic", Category: CategoryPublic})
+	if h, ok := us.IsHoliday(independenceDay); !ok || h.Name != "Company Picnic" {
+		t.Errorf("Expected Company Picnic, got %v", h)
+	}
+	if on := us.HolidaysOn(independenceDay); len(on) != 1 {
+		t.Errorf("Expected only Company Picnic, got %v", on)
+	}
+}
+
+func TestAddCustomHolidayLoadOrder(t *testing.T) {
+	rule := ObservanceRule{Shift: ObservanceNearestWeekday, AddObservedDays: true}
+	saturday := time.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC)
+	custom := &Holiday{Name: "Founders Day", Category: CategoryPublic}
+
+	before := NewCountry("US")
+	before.SetObservanceRule(rule)
+	before.AddCustomHoliday(saturday, custom)
+
+	after := NewCountry("US")
+	after.SetObservanceRule(rule)
+	after.HolidaysForYear(2024)
+	after.AddCustomHoliday(saturday, custom)
+
+	// The weekend holiday is observed on the Friday either way
+	friday := time.Date(2024, 6, 14, 0, 0, 0, 0, time.UTC)
+	for name, country := range map[string]*Country{"before": before, "after": after} {
+		holiday, _ := country.IsHoliday(saturday)
+		if holiday == nil || holiday.Observed == nil || !holiday.Observed.Equal(friday) {
+			t.Errorf("Added %s loading: expected Founders Day observed on %s, got %v", name, friday.Format("2006-01-02"), holiday)
+		}
+	}
+	if !reflect.DeepEqual(before.HolidaysForYear(2024), after.HolidaysForYear(2024)) {
+		t.Error("Expected the same holidays whether added before or after the year loaded")
+	}
+}
+
+func TestCustomHolidayConcurrentReads(t *testing.T) {
+	us := NewCountry("US")
+	us.HolidaysForYear(2024)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				us.AddCustomHoliday(time.Date(2024, 3, 1+i*5+j%5, 0, 0, 0, 0, time.UTC), &Holiday{Name: "Company Day", Category: CategoryPublic})
+			}
+		}(i)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				us.HolidaysForYear(2024)
+				us.IsHoliday(time.Date(2024, 7, 4, 0, 0, 0, 0, time.UTC))
+			}
+		}()
+	}
+	wg.Wait()
+
+	if _, ok := us.IsHoliday(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)); !ok {
+		t.Error("Expected the custom holidays to be loaded")
+	}
+}
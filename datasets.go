@@ -0,0 +1,68 @@
+package goholidays
+
+import (
+	"embed"
+	"encoding/json"
+	"log"
+	"path"
+	"sync"
+
+	"github.com/coredds/goholiday/countries"
+)
+
+// datasetFS holds pre-synced country data in the sync tool's format, one lowercase
+// <code>.json file per country. It serves countries that have no built-in provider
+// without network access at runtime; add a file here to ship another synced country.
+//
+//go:embed datasets/*.json
+var datasetFS embed.FS
+
+// embeddedProviders maps country codes to providers for the embedded datasets
+var (
+	embeddedProvidersOnce sync.Once
+	embeddedProviders     map[string]countries.HolidayProvider
+)
+
+// embeddedProvider returns the provider for code's embedded dataset, if any
+func embeddedProvider(code string) (countries.HolidayProvider, bool) {
+	embeddedProvidersOnce.Do(loadEmbeddedProviders)
+	provider, exists := embeddedProviders[code]
+	return provider, exists
+}
+
+// EmbeddedCountries returns the codes of the countries served from embedded datasets
+func EmbeddedCountries() []string {
+	embeddedProvidersOnce.Do(loadEmbeddedProviders)
+	codes := make([]string, 0, len(embeddedProviders))
+	for code := range embeddedProviders {
+		codes = append(codes, code)
+	}
+	return codes
+}
+
+// loadEmbeddedProviders decodes every embedded dataset. A dataset that cannot be read is
+// logged and skipped, leaving its country unsupported.
+func loadEmbeddedProviders() {
+	embeddedProviders = make(map[string]countries.HolidayProvider)
+
+	entries, err := datasetFS.ReadDir("datasets")
+	if err != nil {
+		log.Printf("WARNING: cannot read embedded datasets: %v", err)
+		return
+	}
+
+	for _, entry := range entries {
+		content, err := datasetFS.ReadFile(path.Join("datasets", entry.Name()))
+		if err != nil {
+			log.Printf("WARNING: cannot read embedded dataset %s: %v", entry.Name(), err)
+			continue
+		}
+
+		var data countries.CountryData
+		if err := json.Unmarshal(content, &data); err != nil || data.CountryCode == "" {
+			log.Printf("WARNING: cannot decode embedded dataset %s: %v", entry.Name(), err)
+			continue
+		}
+		embeddedProviders[data.CountryCode] = countries.NewJSONProvider(data)
+	}
+}
@@ -0,0 +1,47 @@
+package goholidays
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEmbeddedDatasets(t *testing.T) {
+	// Denmark has no Go provider and is served from its embedded dataset
+	dk, err := NewCountryWithError("DK", CountryOptions{Language: "da"})
+	if err != nil {
+		t.Fatalf("Expected DK to be supported by its embedded dataset, got %v", err)
+	}
+
+	tests := []struct {
+		date time.Time
+		name string
+	}{
+		{time.Date(2024, 3, 28, 0, 0, 0, 0, time.UTC), "Maundy Thursday"},
+		{time.Date(2024, 5, 9, 0, 0, 0, 0, time.UTC), "Ascension Day"},
+		{time.Date(2024, 12, 26, 0, 0, 0, 0, time.UTC), "Second Day of Christmas"},
+		{time.Date(2023, 5, 5, 0, 0, 0, 0, time.UTC), "Great Prayer Day"},
+	}
+	for _, tt := range tests {
+		if h, ok := dk.IsHoliday(tt.date); !ok || h.Name != tt.name {
+			t.Errorf("Expected %s on %s, got %v", tt.name, tt.date.Format("2006-01-02"), h)
+		}
+	}
+
+	// Great Prayer Day was abolished from 2024
+	if h, ok := dk.IsHoliday(time.Date(2024, 4, 26, 0, 0, 0, 0, time.UTC)); ok {
+		t.Errorf("Expected no Great Prayer Day in 2024, got %v", h)
+	}
+
+	if h, _ := dk.IsHoliday(time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC)); h == nil || h.Languages["da"] != "Juledag" {
+		t.Errorf("Expected the Danish name of Christmas Day, got %v", h)
+	}
+
+	for _, code := range EmbeddedCountries() {
+		if !SupportedCountries[code] {
+			t.Errorf("Embedded dataset %s is missing from SupportedCountries", code)
+		}
+	}
+	if h, ok := NewCountry("LU").IsHoliday(time.Date(2024, 6, 23, 0, 0, 0, 0, time.UTC)); !ok || h.Name != "National Day" {
+		t.Errorf("Expected Luxembourg's National Day, got %v", h)
+	}
+}
@@ -0,0 +1,41 @@
+package goholidays
+
+import (
+	"fmt"
+	"time"
+)
+
+// Date is a calendar date without a time of day or location. Holidays are keyed by
+// date only, so using Date avoids mismatches caused by timestamps that are not
+// midnight UTC.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// NewDate creates a Date, normalizing out-of-range values the same way time.Date does
+func NewDate(year int, month time.Month, day int) Date {
+	return DateOf(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+}
+
+// DateOf returns the calendar date of t in t's own location
+func DateOf(t time.Time) Date {
+	year, month, day := t.Date()
+	return Date{Year: year, Month: month, Day: day}
+}
+
+// Time returns the date as midnight UTC, the form used as holiday map keys
+func (d Date) Time() time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, time.UTC)
+}
+
+// String formats the date as YYYY-MM-DD
+func (d Date) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, int(d.Month), d.Day)
+}
+
+// IsHolidayDate checks if the given calendar date is a holiday
+func (c *Country) IsHolidayDate(d Date) (*Holiday, bool) {
+	return c.IsHoliday(d.Time())
+}
@@ -0,0 +1,50 @@
+package goholidays
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDateConversions(t *testing.T) {
+	d := NewDate(2024, time.July, 4)
+	if d.String() != "2024-07-04" {
+		t.Errorf("Expected 2024-07-04, got %s", d.String())
+	}
+
+	if !d.Time().Equal(time.Date(2024, 7, 4, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected midnight UTC, got %v", d.Time())
+	}
+
+	// Out-of-range values normalize like time.Date
+	if normalized := NewDate(2024, time.February, 30); normalized != NewDate(2024, time.March, 1) {
+		t.Errorf("Expected 2024-03-01, got %s", normalized)
+	}
+
+	// The calendar date is taken in the time's own location
+	tokyo := time.FixedZone("JST", 9*60*60)
+	evening := time.Date(2024, 7, 4, 23, 30, 0, 0, tokyo)
+	if DateOf(evening) != d {
+		t.Errorf("Expected %s, got %s", d, DateOf(evening))
+	}
+}
+
+func TestIsHolidayDate(t *testing.T) {
+	us := NewCountry("US")
+
+	// A non-midnight timestamp still maps to the holiday's calendar date
+	afternoon := time.Date(2024, 7, 4, 15, 45, 0, 0, time.UTC)
+	holiday, isHoliday := us.IsHolidayDate(DateOf(afternoon))
+	if !isHoliday || holiday.Name != "Independence Day" {
+		t.Errorf("Expected Independence Day, got %v", holiday)
+	}
+
+	newYork := time.FixedZone("EST", -5*60*60)
+	lateEvening := time.Date(2024, 12, 25, 22, 0, 0, 0, newYork)
+	if _, isHoliday := us.IsHolidayDate(DateOf(lateEvening)); !isHoliday {
+		t.Error("Christmas evening in New York should be a holiday")
+	}
+
+	if _, isHoliday := us.IsHolidayDate(NewDate(2024, time.March, 12)); isHoliday {
+		t.Error("March 12 should not be a holiday")
+	}
+}
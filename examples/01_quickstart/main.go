@@ -25,11 +25,10 @@ func main() {
 
 	// 2. Get upcoming holidays
 	fmt.Println("\n2. Next 3 holidays:")
-	holidays := us.HolidaysForYear(today.Year())
 	count := 0
-	for date, holiday := range holidays {
-		if date.After(today) {
-			fmt.Printf("- %s: %s\n", date.Format("Jan 2"), holiday.Name)
+	for _, holiday := range us.SortedHolidaysForYear(today.Year()) {
+		if holiday.Date.After(today) {
+			fmt.Printf("- %s: %s\n", holiday.Date.Format("Jan 2"), holiday.Name)
 			count++
 			if count >= 3 {
 				break
@@ -2,7 +2,6 @@ package main
 
 import (
 	"fmt"
-	"sort"
 	"time"
 
 	goholidays "github.com/coredds/goholiday"
@@ -48,49 +47,25 @@ func main() {
 	end := time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)
 	fmt.Printf("Holidays between %s and %s:\n", start.Format("Jan 2"), end.Format("Jan 2"))
 
-	holidays := us.HolidaysForDateRange(start, end)
-	printSortedHolidays(holidays)
+	for _, holiday := range us.SortedHolidaysForDateRange(start, end) {
+		fmt.Printf("- %s: %s\n", holiday.Date.Format("Jan 2"), holiday.Name)
+	}
 
 	// 4. Year Overview
 	fmt.Println("\n4. Full Year Overview")
 	yearHolidays := us.HolidaysForYear(2024)
 	fmt.Printf("Total holidays in 2024: %d\n", len(yearHolidays))
 
-	// Group by month
-	monthlyCount := make(map[time.Month]int)
-	for date := range yearHolidays {
-		monthlyCount[date.Month()]++
-	}
-
 	// Print monthly distribution
+	monthlyCount := us.MonthlyHolidayCounts(2024)
 	for month := time.January; month <= time.December; month++ {
 		if count := monthlyCount[month]; count > 0 {
 			fmt.Printf("%s: %d holiday(s)\n", month, count)
 		}
 	}
 
-	fmt.Println("\nCheck out other examples for more advanced features!")
-}
-
-func printSortedHolidays(holidays map[time.Time]*goholidays.Holiday) {
-	// Convert to slice for sorting
-	type holidayEntry struct {
-		date    time.Time
-		holiday *goholidays.Holiday
-	}
+	busiest, busiestCount := us.BusiestMonth(2024)
+	fmt.Printf("Busiest month: %s (%d holidays)\n", busiest, busiestCount)
 
-	var entries []holidayEntry
-	for date, holiday := range holidays {
-		entries = append(entries, holidayEntry{date, holiday})
-	}
-
-	// Sort by date
-	sort.Slice(entries, func(i, j int) bool {
-		return entries[i].date.Before(entries[j].date)
-	})
-
-	// Print sorted holidays
-	for _, entry := range entries {
-		fmt.Printf("- %s: %s\n", entry.date.Format("Jan 2"), entry.holiday.Name)
-	}
+	fmt.Println("\nCheck out other examples for more advanced features!")
 }
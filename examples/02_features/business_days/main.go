@@ -90,16 +90,17 @@ func main() {
 
 	// 7. Business Quarter Analysis
 	fmt.Println("\n7. Business Days in Q4 2024")
+	// The quarter is the half-open range [Oct 1, Jan 1): BusinessDaysBetween excludes its
+	// end date, while SortedHolidaysForDateRange includes it, so the listing stops at Dec 31
 	q4Start := time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
-	q4End := time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)
+	q4End := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
 	q4Days := calc.BusinessDaysBetween(q4Start, q4End)
 	fmt.Printf("Total business days in Q4 2024: %d\n", q4Days)
 
 	// List all holidays in Q4
 	fmt.Println("Q4 Holidays:")
-	q4Holidays := us.HolidaysForDateRange(q4Start, q4End)
-	for date, holiday := range q4Holidays {
-		fmt.Printf("- %s: %s\n", date.Format("Jan 2"), holiday.Name)
+	for _, holiday := range us.SortedHolidaysForDateRange(q4Start, q4End.AddDate(0, 0, -1)) {
+		fmt.Printf("- %s: %s\n", holiday.Date.Format("Jan 2"), holiday.Name)
 	}
 
 	fmt.Println("\nThis demonstrates goholiday's business day calculation features!")
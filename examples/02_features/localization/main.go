@@ -2,7 +2,6 @@ package main
 
 import (
 	"fmt"
-	"sort"
 	"time"
 
 	goholidays "github.com/coredds/goholiday"
@@ -16,31 +15,19 @@ func main() {
 	fmt.Println("\n1. Holiday Names in Different Languages")
 
 	// Create providers with different language settings
-	countries := map[string]struct {
-		country   *goholidays.Country
-		languages []string
-	}{
-		"CA": {
-			country:   goholidays.NewCountry("CA", goholidays.CountryOptions{Language: "fr"}),
-			languages: []string{"en", "fr"},
-		},
-		"ES": {
-			country:   goholidays.NewCountry("ES", goholidays.CountryOptions{Language: "es"}),
-			languages: []string{"en", "es"},
-		},
-		"JP": {
-			country:   goholidays.NewCountry("JP", goholidays.CountryOptions{Language: "ja"}),
-			languages: []string{"en", "ja"},
-		},
+	countries := map[string]*goholidays.Country{
+		"CA": goholidays.NewCountry("CA", goholidays.CountryOptions{Language: "fr"}),
+		"ES": goholidays.NewCountry("ES", goholidays.CountryOptions{Language: "es"}),
+		"JP": goholidays.NewCountry("JP", goholidays.CountryOptions{Language: "ja"}),
 	}
 
 	// Check New Year's Day in different languages
 	newYear := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
 	fmt.Println("New Year's Day translations:")
-	for code, info := range countries {
-		if holiday, isHoliday := info.country.IsHoliday(newYear); isHoliday {
+	for code, country := range countries {
+		if holiday, isHoliday := country.IsHoliday(newYear); isHoliday {
 			fmt.Printf("\n%s:\n", code)
-			for _, lang := range info.languages {
+			for _, lang := range country.AvailableLanguages(newYear.Year()) {
 				fmt.Printf("- %s: %s\n", lang, holiday.Languages[lang])
 			}
 		}
@@ -55,8 +42,7 @@ func main() {
 		ch := goholidays.NewCountry("CH", goholidays.CountryOptions{Language: lang})
 		fmt.Printf("\nSwiss holidays in %s:\n", lang)
 
-		holidays := ch.HolidaysForYear(2024)
-		printSortedHolidaysWithLanguage(holidays, lang)
+		printHolidaysWithLanguage(ch.SortedHolidaysForYear(2024), lang)
 	}
 
 	// 3. Country-Specific Formatting
@@ -107,42 +93,20 @@ func main() {
 			Subdivisions: []string{state},
 		})
 		fmt.Printf("\nHolidays specific to %s:\n", state)
-		stateHolidays := us.HolidaysForYear(2024)
-		printSortedHolidays(stateHolidays)
+		printHolidays(us.SortedHolidaysForYear(2024))
 	}
 
 	fmt.Println("\nThis demonstrates goholiday's localization capabilities!")
 }
 
-func printSortedHolidays(holidays map[time.Time]*goholidays.Holiday) {
-	var dates []time.Time
-	for date := range holidays {
-		dates = append(dates, date)
-	}
-	sort.Slice(dates, func(i, j int) bool {
-		return dates[i].Before(dates[j])
-	})
-
-	for _, date := range dates {
-		fmt.Printf("- %s: %s\n", date.Format("Jan 2"), holidays[date].Name)
+func printHolidays(holidays []*goholidays.Holiday) {
+	for _, holiday := range holidays {
+		fmt.Printf("- %s: %s\n", holiday.Date.Format("Jan 2"), holiday.Name)
 	}
 }
 
-func printSortedHolidaysWithLanguage(holidays map[time.Time]*goholidays.Holiday, lang string) {
-	var dates []time.Time
-	for date := range holidays {
-		dates = append(dates, date)
-	}
-	sort.Slice(dates, func(i, j int) bool {
-		return dates[i].Before(dates[j])
-	})
-
-	for _, date := range dates {
-		holiday := holidays[date]
-		name := holiday.Languages[lang]
-		if name == "" {
-			name = holiday.Name // fallback to default name
-		}
-		fmt.Printf("- %s: %s\n", date.Format("Jan 2"), name)
+func printHolidaysWithLanguage(holidays []*goholidays.Holiday, lang string) {
+	for _, holiday := range holidays {
+		fmt.Printf("- %s: %s\n", holiday.Date.Format("Jan 2"), holiday.LocalName(lang))
 	}
 }
@@ -2,7 +2,6 @@ package main
 
 import (
 	"fmt"
-	"sort"
 	"time"
 
 	goholidays "github.com/coredds/goholiday"
@@ -43,18 +42,19 @@ func main() {
 	}
 
 	// 2. Country-specific holidays
-	fmt.Println("\n2. Unique National Holidays (2024)")
-	uniqueHolidays := map[string]time.Time{
-		"US": time.Date(2024, 7, 4, 0, 0, 0, 0, time.UTC),  // Independence Day
-		"GB": time.Date(2024, 6, 8, 0, 0, 0, 0, time.UTC),  // Queen's Birthday
-		"JP": time.Date(2024, 2, 11, 0, 0, 0, 0, time.UTC), // National Foundation Day
-		"AU": time.Date(2024, 1, 26, 0, 0, 0, 0, time.UTC), // Australia Day
+	fmt.Println("\n2. US and GB Holiday Comparison (2024)")
+	comparison := goholidays.CompareCountries(countries["US"], countries["GB"], 2024)
+	for _, pair := range comparison.Shared {
+		fmt.Printf("Both: %s (%s)\n", pair.A.Name, pair.Date.Format("Jan 2"))
 	}
-
-	for code, date := range uniqueHolidays {
-		if holiday, isHoliday := countries[code].IsHoliday(date); isHoliday {
-			fmt.Printf("%s: %s (%s)\n", code, holiday.Name, date.Format("Jan 2"))
-		}
+	for _, pair := range comparison.SameDateDifferentName {
+		fmt.Printf("Same day: US %s, GB %s (%s)\n", pair.A.Name, pair.B.Name, pair.Date.Format("Jan 2"))
+	}
+	for _, holiday := range comparison.OnlyA {
+		fmt.Printf("US only: %s (%s)\n", holiday.Name, holiday.Date.Format("Jan 2"))
+	}
+	for _, holiday := range comparison.OnlyB {
+		fmt.Printf("GB only: %s (%s)\n", holiday.Name, holiday.Date.Format("Jan 2"))
 	}
 
 	// 3. Holiday Count Comparison
@@ -70,8 +70,8 @@ func main() {
 	for code, country := range countries {
 		if holiday, isHoliday := country.IsHoliday(christmas); isHoliday {
 			fmt.Printf("\n%s Christmas names:\n", code)
-			for lang, name := range holiday.Languages {
-				fmt.Printf("- %s: %s\n", lang, name)
+			for _, translation := range holiday.SortedLanguages() {
+				fmt.Printf("- %s: %s\n", translation.Lang, translation.Name)
 			}
 		}
 	}
@@ -92,29 +92,10 @@ func main() {
 	fmt.Println("\n6. Holiday Categories by Country")
 	for code, country := range countries {
 		fmt.Printf("\n%s Categories:\n", code)
-		holidays := country.HolidaysForYear(2024)
-		categories := make(map[goholidays.HolidayCategory]int)
-		for _, holiday := range holidays {
-			categories[holiday.Category]++
-		}
-		for category, count := range categories {
+		for category, count := range country.Statistics(2024).ByCategory {
 			fmt.Printf("- %s: %d holiday(s)\n", category, count)
 		}
 	}
 
 	fmt.Println("\nThis demonstrates the power of goholiday's multi-country support!")
 }
-
-func printSortedHolidays(holidays map[time.Time]*goholidays.Holiday) {
-	var dates []time.Time
-	for date := range holidays {
-		dates = append(dates, date)
-	}
-	sort.Slice(dates, func(i, j int) bool {
-		return dates[i].Before(dates[j])
-	})
-
-	for _, date := range dates {
-		fmt.Printf("- %s: %s\n", date.Format("Jan 2"), holidays[date].Name)
-	}
-}
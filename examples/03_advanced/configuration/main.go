@@ -230,38 +230,7 @@ logging:
 	_ = yaml.Unmarshal([]byte(baseConfig), baseCfg)
 	_ = yaml.Unmarshal([]byte(overrideConfig), overrideCfg)
 
-	// Merge manually since we don't have a Merge function
-	merged := &config.Config{
-		General: config.GeneralConfig{
-			DefaultCountry:  baseCfg.General.DefaultCountry,
-			DefaultLanguage: baseCfg.General.DefaultLanguage,
-		},
-		Performance: config.PerformanceConfig{
-			EnableCaching: baseCfg.Performance.EnableCaching,
-			CacheTTL:      baseCfg.Performance.CacheTTL,
-		},
-		Logging: config.LoggingConfig{
-			Level:  baseCfg.Logging.Level,
-			Format: baseCfg.Logging.Format,
-		},
-	}
-
-	// Override with values from override config
-	if overrideCfg.General.DefaultLanguage != "" {
-		merged.General.DefaultLanguage = overrideCfg.General.DefaultLanguage
-	}
-	if overrideCfg.Performance.CacheTTL != 0 {
-		merged.Performance.CacheTTL = overrideCfg.Performance.CacheTTL
-	}
-	if overrideCfg.Performance.MaxCacheSize != 0 {
-		merged.Performance.MaxCacheSize = overrideCfg.Performance.MaxCacheSize
-	}
-	if overrideCfg.Logging.Format != "" {
-		merged.Logging.Format = overrideCfg.Logging.Format
-	}
-	if overrideCfg.Logging.Output != "" {
-		merged.Logging.Output = overrideCfg.Logging.Output
-	}
+	merged := baseCfg.Merge(overrideCfg)
 
 	fmt.Println("Merged configuration:")
 	fmt.Printf("- Default Language: %s\n", merged.General.DefaultLanguage)
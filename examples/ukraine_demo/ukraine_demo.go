@@ -2,7 +2,6 @@ package main
 
 import (
 	"fmt"
-	"sort"
 	"time"
 
 	goholidays "github.com/coredds/goholiday"
@@ -26,34 +25,24 @@ func main() {
 
 	// Demonstrate key Ukrainian holidays for 2024
 	year := 2024
-	holidays := ua.HolidaysForYear(year)
+	holidays := ua.SortedHolidaysForYear(year)
 	fmt.Printf("📅 Ukrainian holidays in %d: %d total\n\n", year, len(holidays))
 
-	// Sort holidays by date for better display
-	var sortedDates []time.Time
-	for date := range holidays {
-		sortedDates = append(sortedDates, date)
-	}
-	sort.Slice(sortedDates, func(i, j int) bool {
-		return sortedDates[i].Before(sortedDates[j])
-	})
-
 	// Group holidays by category
-	categoryHolidays := make(map[string][]time.Time)
-	for _, date := range sortedDates {
-		holiday := holidays[date]
-		categoryHolidays[string(holiday.Category)] = append(categoryHolidays[string(holiday.Category)], date)
+	categoryHolidays := make(map[string][]*goholidays.Holiday)
+	for _, holiday := range holidays {
+		categoryHolidays[string(holiday.Category)] = append(categoryHolidays[string(holiday.Category)], holiday)
 	}
 
 	// Display holidays by category
 	categories := []string{"national", "orthodox", "memorial", "cultural"}
 
 	for _, category := range categories {
-		if dates, exists := categoryHolidays[category]; exists {
-			fmt.Printf("🏛️  %s holidays (%d):\n", category, len(dates))
-			for _, date := range dates {
-				holiday := holidays[date]
-				fmt.Printf("   %s - %s\n", date.Format("Jan 02"), holiday.Name)
+		if categoryList, exists := categoryHolidays[category]; exists {
+			display := goholidays.DisplayForCategory(goholidays.HolidayCategory(category))
+			fmt.Printf("%s  %s holidays (%d):\n", display.Icon, category, len(categoryList))
+			for _, holiday := range categoryList {
+				fmt.Printf("   %s - %s\n", holiday.Date.Format("Jan 02"), holiday.Name)
 				if holiday.Languages["uk"] != "" {
 					fmt.Printf("      🇺🇦 %s\n", holiday.Languages["uk"])
 				}
@@ -133,7 +122,7 @@ func main() {
 	}
 
 	for _, date := range keyHolidays {
-		if holiday, exists := holidays[date]; exists {
+		if holiday, exists := ua.IsHoliday(date); exists {
 			fmt.Printf("%s:\n", date.Format("January 2"))
 			fmt.Printf("   🇬🇧 English: %s\n", holiday.Languages["en"])
 			fmt.Printf("   🇺🇦 Ukrainian: %s\n", holiday.Languages["uk"])
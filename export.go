@@ -0,0 +1,313 @@
+package goholidays
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ObservedMode controls which dates are exported for holidays that have an observed date
+type ObservedMode int
+
+const (
+	// ObservedActualOnly exports the actual date of every holiday
+	ObservedActualOnly ObservedMode = iota
+	// ObservedOnly exports the observed day off in place of the actual date when they differ
+	ObservedOnly
+	// ObservedBoth exports the actual date plus a separate entry for the observed date
+	ObservedBoth
+)
+
+// ExportEntry is a single dated holiday in an export
+type ExportEntry struct {
+	Date      time.Time         `json:"date"`
+	Name      string            `json:"name"`
+	Category  HolidayCategory   `json:"category"`
+	Observed  bool              `json:"observed"` // Date is the observed day rather than the actual one
+	Languages map[string]string `json:"languages,omitempty"`
+}
+
+// ExportEntries returns the holidays of a year as export entries sorted by date
+func (c *Country) ExportEntries(year int, mode ObservedMode) []ExportEntry {
+	var entries []ExportEntry
+
+	for date, holiday := range c.HolidaysForYear(year) {
+		entry := ExportEntry{
+			Date:      date,
+			Name:      holiday.Name,
+			Category:  holiday.Category,
+			Languages: holiday.Languages,
+		}
+
+		hasObserved := holiday.IsObserved && holiday.Observed != nil && !holiday.Observed.Equal(date)
+		if !hasObserved || mode == ObservedActualOnly {
+			entries = append(entries, entry)
+			continue
+		}
+
+		if mode == ObservedBoth {
+			entries = append(entries, entry)
+		}
+
+		entry.Date = *holiday.Observed
+		entry.Observed = true
+		entries = append(entries, entry)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if !entries[i].Date.Equal(entries[j].Date) {
+			return entries[i].Date.Before(entries[j].Date)
+		}
+		return entries[i].Name < entries[j].Name
+	})
+
+	return entries
+}
+
+// ExportJSON writes the holidays of a year to w as a JSON array of export entries
+func (c *Country) ExportJSON(w io.Writer, year int, mode ObservedMode) error {
+	entries := c.ExportEntries(year, mode)
+	if entries == nil {
+		entries = []ExportEntry{}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(entries)
+}
+
+// NonBusinessDay is a date on which a BusinessDayCalculator's market is closed
+type NonBusinessDay struct {
+	Date   string `json:"date"`           // YYYY-MM-DD
+	Reason string `json:"reason"`         // "weekend", "holiday" or "closure"
+	Name   string `json:"name,omitempty"` // Holiday name when Reason is "holiday"
+}
+
+// ExportBusinessCalendar writes the non-business days between start and end inclusive to w,
+// in date order, as "csv" (with a date,reason,name header) or "json". Weekends, holidays and
+// extra closures are all included, so the output can seed calendars in tools such as QuantLib.
+func ExportBusinessCalendar(w io.Writer, b *BusinessDayCalculator, start, end time.Time, format string) error {
+	if start.After(end) {
+		return NewHolidayError(ErrInvalidDate, "start date cannot be after end date")
+	}
+
+	days := []NonBusinessDay{}
+	first := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+	last := time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, time.UTC)
+	for date := first; !date.After(last); date = date.AddDate(0, 0, 1) {
+		reason, holiday := b.closureReason(date)
+		if reason == "" {
+			continue
+		}
+		day := NonBusinessDay{Date: date.Format("2006-01-02"), Reason: reason}
+		if holiday != nil {
+			day.Name = holiday.Name
+		}
+		days = append(days, day)
+	}
+
+	switch format {
+	case "csv":
+		writer := csv.NewWriter(w)
+		if err := writer.Write([]string{"date", "reason", "name"}); err != nil {
+			return err
+		}
+		for _, day := range days {
+			if err := writer.Write([]string{day.Date, day.Reason, day.Name}); err != nil {
+				return err
+			}
+		}
+		writer.Flush()
+		return writer.Error()
+	case "json":
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(days)
+	default:
+		return fmt.Errorf("unsupported business calendar format %q (want csv or json)", format)
+	}
+}
+
+// DatasetRow is a single holiday in a multi-country, multi-year dataset export
+type DatasetRow struct {
+	Country string `json:"country"`
+	ExportEntry
+}
+
+// ExportDataset writes the holidays of every country in codes for startYear through
+// endYear to w, as "csv" (with a country,date,name,category,observed header) or "jsonl"
+// (one DatasetRow object per line). Rows are streamed as they are produced: each
+// country-year is loaded into a fresh Country, written and released before the next,
+// so memory use does not grow with the number of years or countries exported.
+func ExportDataset(w io.Writer, codes []string, startYear, endYear int, mode ObservedMode, format string) error {
+	if startYear > endYear {
+		return NewHolidayError(ErrInvalidYear,
+			fmt.Sprintf("start year %d cannot be after end year %d", startYear, endYear))
+	}
+	for _, year := range []int{startYear, endYear} {
+		if err := ValidateYear(year); err != nil {
+			return err
+		}
+	}
+	for _, code := range codes {
+		if err := ValidateCountryCode(code); err != nil {
+			return err
+		}
+	}
+
+	var writeRow func(row DatasetRow) error
+	var flush func() error
+
+	switch format {
+	case "csv":
+		writer := csv.NewWriter(w)
+		if err := writer.Write([]string{"country", "date", "name", "category", "observed"}); err != nil {
+			return err
+		}
+		writeRow = func(row DatasetRow) error {
+			return writer.Write([]string{
+				row.Country,
+				row.Date.Format("2006-01-02"),
+				row.Name,
+				string(row.Category),
+				fmt.Sprintf("%t", row.Observed),
+			})
+		}
+		flush = func() error {
+			writer.Flush()
+			return writer.Error()
+		}
+	case "jsonl":
+		encoder := json.NewEncoder(w)
+		writeRow = func(row DatasetRow) error {
+			return encoder.Encode(row)
+		}
+		flush = func() error { return nil }
+	default:
+		return fmt.Errorf("unsupported dataset format %q (want csv or jsonl)", format)
+	}
+
+	for _, code := range codes {
+		for year := startYear; year <= endYear; year++ {
+			for _, entry := range NewCountry(code).ExportEntries(year, mode) {
+				if err := writeRow(DatasetRow{Country: code, ExportEntry: entry}); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return flush()
+}
+
+// ExportICS writes the holidays of a year to w as an RFC 5545 iCalendar feed of all-day
+// events. When categories are given only holidays in one of them are written, so the same
+// country can publish separate feeds, e.g. one of bank holidays only. A category matches
+// holidays of that category or of a provider-specific category that maps onto it.
+// Each event's DESCRIPTION lists the holiday's name in every language it has.
+func (c *Country) ExportICS(w io.Writer, year int, categories ...HolidayCategory) error {
+	var b strings.Builder
+
+	writeICSLine(&b, "BEGIN:VCALENDAR")
+	writeICSLine(&b, "VERSION:2.0")
+	writeICSLine(&b, "PRODID:-//goholidays//goholidays "+Version+"//EN")
+	writeICSLine(&b, "CALSCALE:GREGORIAN")
+	writeICSLine(&b, "METHOD:PUBLISH")
+
+	for _, primary := range c.SortedHolidaysForYear(year) {
+		for _, holiday := range c.HolidaysOn(primary.Date) {
+			if !matchesCategories(holiday.Category, categories) {
+				continue
+			}
+			date := primary.Date
+			writeICSLine(&b, "BEGIN:VEVENT")
+			writeICSLine(&b, "UID:"+icsUID(c.code, date, holiday.Name))
+			writeICSLine(&b, "DTSTAMP:"+date.Format("20060102")+"T000000Z")
+			writeICSLine(&b, "DTSTART;VALUE=DATE:"+date.Format("20060102"))
+			writeICSLine(&b, "DTEND;VALUE=DATE:"+date.AddDate(0, 0, 1).Format("20060102"))
+			writeICSLine(&b, "SUMMARY:"+escapeICSText(holiday.Name))
+			writeICSLine(&b, "CATEGORIES:"+escapeICSText(string(holiday.Category)))
+			if description := icsDescription(holiday); description != "" {
+				writeICSLine(&b, "DESCRIPTION:"+escapeICSText(description))
+			}
+			writeICSLine(&b, "TRANSP:TRANSPARENT")
+			writeICSLine(&b, "END:VEVENT")
+		}
+	}
+
+	writeICSLine(&b, "END:VCALENDAR")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// icsDescription lists a holiday's names in every language it has, one per line
+func icsDescription(holiday *Holiday) string {
+	lines := make([]string, 0, len(holiday.Languages))
+	for _, name := range holiday.SortedLanguages() {
+		lines = append(lines, name.Lang+": "+name.Name)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// matchesCategories reports whether category is one of categories, directly or through
+// its canonical category; an empty list matches every category
+func matchesCategories(category HolidayCategory, categories []HolidayCategory) bool {
+	if len(categories) == 0 {
+		return true
+	}
+	canonical := canonicalCategory(category)
+	for _, wanted := range categories {
+		if wanted == category || wanted == canonical {
+			return true
+		}
+	}
+	return false
+}
+
+// icsUID returns a UID that stays the same across exports of the same holiday
+func icsUID(code string, date time.Time, name string) string {
+	var slug strings.Builder
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			slug.WriteRune(r)
+		case slug.Len() > 0 && !strings.HasSuffix(slug.String(), "-"):
+			slug.WriteByte('-')
+		}
+	}
+	hash := fnv.New32a()
+	hash.Write([]byte(name))
+
+	return fmt.Sprintf("%s-%s-%s-%08x@goholidays", date.Format("20060102"),
+		strings.ToLower(code), strings.TrimSuffix(slug.String(), "-"), hash.Sum32())
+}
+
+// escapeICSText escapes a TEXT property value as required by RFC 5545
+func escapeICSText(text string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`).Replace(text)
+}
+
+// writeICSLine writes a content line terminated by CRLF, folding it so that no line
+// exceeds 75 octets without splitting a UTF-8 sequence
+func writeICSLine(b *strings.Builder, line string) {
+	limit := 75
+	for len(line) > limit {
+		cut := limit
+		for !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		limit = 74 // Continuation lines start with a space
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
@@ -0,0 +1,391 @@
+package goholidays
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func TestExportEntriesObservedModes(t *testing.T) {
+	us := NewCountry("US")
+
+	// Independence Day 2021 fell on a Sunday and was observed on Monday, July 5
+	actual := time.Date(2021, 7, 4, 0, 0, 0, 0, time.UTC)
+	observed := time.Date(2021, 7, 5, 0, 0, 0, 0, time.UTC)
+
+	find := func(entries []ExportEntry, date time.Time) (ExportEntry, bool) {
+		for _, entry := range entries {
+			if entry.Date.Equal(date) && entry.Name == "Independence Day" {
+				return entry, true
+			}
+		}
+		return ExportEntry{}, false
+	}
+
+	t.Run("Actual Only", func(t *testing.T) {
+		entries := us.ExportEntries(2021, ObservedActualOnly)
+		if _, ok := find(entries, actual); !ok {
+			t.Error("Expected actual date to be exported")
+		}
+		if _, ok := find(entries, observed); ok {
+			t.Error("Observed date should not be exported")
+		}
+	})
+
+	t.Run("Observed Only", func(t *testing.T) {
+		entries := us.ExportEntries(2021, ObservedOnly)
+		if _, ok := find(entries, actual); ok {
+			t.Error("Actual date should not be exported")
+		}
+		entry, ok := find(entries, observed)
+		if !ok || !entry.Observed {
+			t.Error("Expected observed date to be exported and marked observed")
+		}
+	})
+
+	t.Run("Both", func(t *testing.T) {
+		entries := us.ExportEntries(2021, ObservedBoth)
+		entry, ok := find(entries, actual)
+		if !ok || entry.Observed {
+			t.Error("Expected actual date to be exported unmarked")
+		}
+		entry, ok = find(entries, observed)
+		if !ok || !entry.Observed {
+			t.Error("Expected observed date to be exported and marked observed")
+		}
+		if len(entries) <= len(us.HolidaysForYear(2021)) {
+			t.Errorf("Expected extra observed entries, got %d entries", len(entries))
+		}
+	})
+}
+
+func TestExportJSON(t *testing.T) {
+	us := NewCountry("US")
+
+	var buf bytes.Buffer
+	if err := us.ExportJSON(&buf, 2021, ObservedOnly); err != nil {
+		t.Fatalf("ExportJSON() failed: %v", err)
+	}
+
+	var entries []ExportEntry
+	if err := json.Unmarshal(buf.Bytes(), &entries); err != nil {
+		t.Fatalf("Failed to decode exported JSON: %v", err)
+	}
+
+	if len(entries) != len(us.HolidaysForYear(2021)) {
+		t.Errorf("Expected %d entries, got %d", len(us.HolidaysForYear(2021)), len(entries))
+	}
+
+	for i := 1; i < len(entries); i++ {
+		if entries[i].Date.Before(entries[i-1].Date) {
+			t.Errorf("Entries not sorted by date at index %d", i)
+		}
+	}
+}
+
+func TestExportBusinessCalendar(t *testing.T) {
+	calc := NewBusinessDayCalculator(NewCountry("US"))
+	start := time.Date(2024, 12, 20, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 12, 28, 0, 0, 0, 0, time.UTC)
+
+	var buf bytes.Buffer
+	if err := ExportBusinessCalendar(&buf, calc, start, end, "csv"); err != nil {
+		t.Fatalf("ExportBusinessCalendar csv failed: %v", err)
+	}
+
+	expected := "date,reason,name\n" +
+		"2024-12-21,weekend,\n" +
+		"2024-12-22,weekend,\n" +
+		"2024-12-25,holiday,Christmas Day\n" +
+		"2024-12-28,weekend,\n"
+	if buf.String() != expected {
+		t.Errorf("Unexpected csv output:\n%s\nexpected:\n%s", buf.String(), expected)
+	}
+
+	buf.Reset()
+	if err := ExportBusinessCalendar(&buf, calc, start, end, "json"); err != nil {
+		t.Fatalf("ExportBusinessCalendar json failed: %v", err)
+	}
+	var days []NonBusinessDay
+	if err := json.Unmarshal(buf.Bytes(), &days); err != nil {
+		t.Fatalf("Invalid JSON output: %v", err)
+	}
+	if len(days) != 4 || days[2].Date != "2024-12-25" || days[2].Reason != "holiday" {
+		t.Errorf("Unexpected json output: %+v", days)
+	}
+
+	if err := ExportBusinessCalendar(&buf, calc, start, end, "xml"); err == nil {
+		t.Error("Expected an error for an unsupported format")
+	}
+	if err := ExportBusinessCalendar(&buf, calc, end, start, "csv"); err == nil {
+		t.Error("Expected an error when start is after end")
+	}
+}
+
+// heapSamplingWriter discards its input, recording the largest live heap seen while writing
+type heapSamplingWriter struct {
+	writes int
+	peak   uint64
+}
+
+func (w *heapSamplingWriter) Write(p []byte) (int, error) {
+	w.writes++
+	if w.writes%16 == 0 {
+		var stats runtime.MemStats
+		runtime.ReadMemStats(&stats)
+		if stats.HeapAlloc > w.peak {
+			w.peak = stats.HeapAlloc
+		}
+	}
+	return len(p), nil
+}
+
+var datasetCountries = []string{"US", "GB", "DE", "NZ", "JP"}
+
+func TestExportDataset(t *testing.T) {
+	codes := []string{"US", "GB"}
+
+	t.Run("CSV", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := ExportDataset(&buf, codes, 2023, 2024, ObservedActualOnly, "csv"); err != nil {
+			t.Fatalf("ExportDataset() failed: %v", err)
+		}
+
+		records, err := csv.NewReader(&buf).ReadAll()
+		if err != nil {
+			t.Fatalf("Failed to read exported CSV: %v", err)
+		}
+		if strings.Join(records[0], ",") != "country,date,name,category,observed" {
+			t.Errorf("Unexpected header %v", records[0])
+		}
+
+		expected := 0
+		for _, code := range codes {
+			for year := 2023; year <= 2024; year++ {
+				expected += len(NewCountry(code).HolidaysForYear(year))
+			}
+		}
+		if len(records)-1 != expected {
+			t.Errorf("Expected %d rows, got %d", expected, len(records)-1)
+		}
+		if records[1][0] != "US" || records[len(records)-1][0] != "GB" {
+			t.Errorf("Expected rows grouped by country in order, got first %v and last %v", records[1], records[len(records)-1])
+		}
+	})
+
+	t.Run("JSONL", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := ExportDataset(&buf, codes, 2024, 2024, ObservedActualOnly, "jsonl"); err != nil {
+			t.Fatalf("ExportDataset() failed: %v", err)
+		}
+
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		var row DatasetRow
+		if err := json.Unmarshal([]byte(lines[0]), &row); err != nil {
+			t.Fatalf("Failed to decode JSONL row: %v", err)
+		}
+		if row.Country != "US" || row.Name != "New Year's Day" {
+			t.Errorf("Expected US New Year's Day first, got %+v", row)
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		if err := ExportDataset(io.Discard, codes, 2024, 2023, ObservedActualOnly, "csv"); err == nil {
+			t.Error("Expected an error for a reversed year range")
+		}
+		if err := ExportDataset(io.Discard, []string{"XX"}, 2024, 2024, ObservedActualOnly, "csv"); err == nil {
+			t.Error("Expected an error for an unsupported country")
+		}
+		if err := ExportDataset(io.Discard, codes, 2024, 2024, ObservedActualOnly, "xml"); err == nil {
+			t.Error("Expected an error for an unsupported format")
+		}
+	})
+}
+
+func TestExportDatasetBoundedMemory(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping 50-year export in short mode")
+	}
+
+	var before runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+
+	writer := &heapSamplingWriter{}
+	if err := ExportDataset(writer, datasetCountries, 1975, 2024, ObservedBoth, "jsonl"); err != nil {
+		t.Fatalf("ExportDataset() failed: %v", err)
+	}
+
+	var after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&after)
+
+	// Nothing from the export should remain reachable once it returns
+	const retainedLimit = 512 << 10
+	if after.HeapAlloc > before.HeapAlloc && after.HeapAlloc-before.HeapAlloc > retainedLimit {
+		t.Errorf("Export retained %d bytes, expected at most %d", after.HeapAlloc-before.HeapAlloc, retainedLimit)
+	}
+
+	// The heap while streaming is bounded by one country-year plus garbage awaiting collection
+	const peakLimit = 8 << 20
+	if writer.peak > before.HeapAlloc && writer.peak-before.HeapAlloc > peakLimit {
+		t.Errorf("Heap grew by %d bytes while exporting, expected at most %d", writer.peak-before.HeapAlloc, peakLimit)
+	}
+	t.Logf("%d writes, retained %d bytes, peak growth %d bytes",
+		writer.writes, int64(after.HeapAlloc)-int64(before.HeapAlloc), int64(writer.peak)-int64(before.HeapAlloc))
+}
+
+func BenchmarkExportDataset(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := ExportDataset(io.Discard, datasetCountries, 1975, 2024, ObservedBoth, "csv"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestExportICSCategoryFeeds(t *testing.T) {
+	gb := NewCountry("GB")
+
+	export := func(categories ...HolidayCategory) string {
+		var buf bytes.Buffer
+		if err := gb.ExportICS(&buf, 2024, categories...); err != nil {
+			t.Fatalf("ExportICS() failed: %v", err)
+		}
+		return buf.String()
+	}
+
+	bank := export(CategoryBank)
+	if !strings.HasPrefix(bank, "BEGIN:VCALENDAR\r\n") || !strings.HasSuffix(bank, "END:VCALENDAR\r\n") {
+		t.Errorf("Expected a complete VCALENDAR, got:\n%s", bank)
+	}
+	for _, name := range []string{"Early May Bank Holiday", "Spring Bank Holiday", "Summer Bank Holiday"} {
+		if !strings.Contains(bank, "SUMMARY:"+name+"\r\n") {
+			t.Errorf("Expected bank feed to contain %s", name)
+		}
+	}
+	if strings.Contains(bank, "Christmas Day") || strings.Contains(bank, "CATEGORIES:public") {
+		t.Error("Bank feed should not contain public holidays")
+	}
+	if got := strings.Count(bank, "BEGIN:VEVENT"); got != 3 {
+		t.Errorf("Expected 3 bank holiday events, got %d", got)
+	}
+
+	public := export(CategoryPublic)
+	if !strings.Contains(public, "SUMMARY:Christmas Day\r\n") || strings.Contains(public, "CATEGORIES:bank") {
+		t.Error("Public feed should contain public holidays only")
+	}
+
+	all := export()
+	if got, expected := strings.Count(all, "BEGIN:VEVENT"), len(gb.HolidaysForYear(2024)); got != expected {
+		t.Errorf("Expected %d events without a category filter, got %d", expected, got)
+	}
+	if !strings.Contains(all, "DTSTART;VALUE=DATE:20241225\r\nDTEND;VALUE=DATE:20241226\r\n") {
+		t.Error("Expected Christmas Day as an all-day event")
+	}
+	if again := export(); again != all {
+		t.Error("Expected repeated exports to be identical")
+	}
+}
+
+// parseICS unfolds an iCalendar feed and returns the properties of each VEVENT, failing
+// the test on structural errors
+func parseICS(t *testing.T, feed string) []map[string]string {
+	t.Helper()
+
+	if !strings.HasSuffix(feed, "\r\n") {
+		t.Fatal("Feed does not end with CRLF")
+	}
+	var events []map[string]string
+	var event map[string]string
+	depth := 0
+	for _, line := range strings.Split(strings.ReplaceAll(strings.TrimSuffix(feed, "\r\n"), "\r\n ", ""), "\r\n") {
+		name, value, ok := strings.Cut(line, ":")
+		if !ok {
+			t.Fatalf("Malformed content line %q", line)
+		}
+		switch {
+		case name == "BEGIN":
+			depth++
+			if value == "VEVENT" {
+				event = map[string]string{}
+			}
+		case name == "END":
+			depth--
+			if value == "VEVENT" {
+				events = append(events, event)
+				event = nil
+			}
+		case event != nil:
+			event[name] = value
+		}
+	}
+	if depth != 0 {
+		t.Fatalf("Unbalanced BEGIN/END in feed")
+	}
+	return events
+}
+
+func TestExportICSEvents(t *testing.T) {
+	fr := NewCountry("FR")
+	var buf bytes.Buffer
+	if err := fr.ExportICS(&buf, 2024); err != nil {
+		t.Fatalf("ExportICS() failed: %v", err)
+	}
+
+	events := parseICS(t, buf.String())
+	if len(events) != len(fr.HolidaysForYear(2024)) {
+		t.Fatalf("Expected %d events, got %d", len(fr.HolidaysForYear(2024)), len(events))
+	}
+
+	uids := make(map[string]bool)
+	for _, event := range events {
+		for _, property := range []string{"UID", "DTSTAMP", "DTSTART;VALUE=DATE", "DTEND;VALUE=DATE", "SUMMARY", "CATEGORIES"} {
+			if event[property] == "" {
+				t.Errorf("Event %v is missing %s", event, property)
+			}
+		}
+		if uids[event["UID"]] {
+			t.Errorf("Duplicate UID %s", event["UID"])
+		}
+		uids[event["UID"]] = true
+	}
+
+	var christmas map[string]string
+	for _, event := range events {
+		if event["DTSTART;VALUE=DATE"] == "20241225" {
+			christmas = event
+		}
+	}
+	if christmas == nil {
+		t.Fatal("Expected a Christmas event")
+	}
+	if christmas["DESCRIPTION"] != `en: Christmas Day\nfr: Noël` {
+		t.Errorf("Expected both names in DESCRIPTION, got %q", christmas["DESCRIPTION"])
+	}
+}
+
+func TestWriteICSLineFolding(t *testing.T) {
+	var b strings.Builder
+	writeICSLine(&b, "SUMMARY:"+strings.Repeat("é", 100))
+
+	for _, line := range strings.Split(strings.TrimSuffix(b.String(), "\r\n"), "\r\n") {
+		if len(line) > 75 {
+			t.Errorf("Line exceeds 75 octets: %d", len(line))
+		}
+		if !utf8.ValidString(line) {
+			t.Errorf("Folding split a UTF-8 sequence: %q", line)
+		}
+	}
+
+	unfolded := strings.ReplaceAll(strings.TrimSuffix(b.String(), "\r\n"), "\r\n ", "")
+	if unfolded != "SUMMARY:"+strings.Repeat("é", 100) {
+		t.Errorf("Unfolding did not restore the line, got %q", unfolded)
+	}
+}
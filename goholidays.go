@@ -6,14 +6,18 @@ package goholidays
 import (
 	"context"
 	"fmt"
+	"hash/fnv"
+	"log"
+	"sort"
 	"sync"
 	"time"
 
+	"github.com/coredds/goholiday/config"
 	"github.com/coredds/goholiday/countries"
 )
 
 // Version represents the current version of the goholiday library
-const Version = "0.6.5"
+const Version = "0.6.6"
 
 // ErrorCode represents different types of errors that can occur
 type ErrorCode int
@@ -36,6 +40,9 @@ const (
 
 	// ErrProviderNotFound indicates no provider exists for the country
 	ErrProviderNotFound
+
+	// ErrHolidayNotFound indicates no holiday was found within the search window
+	ErrHolidayNotFound
 )
 
 // HolidayError represents a structured error with context about what went wrong
@@ -105,14 +112,14 @@ func NewYearError(code ErrorCode, country string, year int, message string) *Hol
 	}
 }
 
-// SupportedCountries contains all countries that have holiday providers
+// SupportedCountries contains all countries that have holiday providers or embedded datasets
 var SupportedCountries = map[string]bool{
 	"AR": true, "AT": true, "AU": true, "BE": true, "BR": true, "CA": true,
-	"CH": true, "CL": true, "CN": true, "DE": true, "ES": true, "FI": true,
-	"FR": true, "GB": true, "ID": true, "IE": true, "IL": true, "IN": true,
-	"IT": true, "JP": true, "KR": true, "MX": true, "NL": true, "NO": true,
-	"NZ": true, "PL": true, "PT": true, "RU": true, "SE": true, "SG": true,
-	"TH": true, "TR": true, "UA": true, "US": true,
+	"CH": true, "CL": true, "CN": true, "DE": true, "DK": true, "ES": true,
+	"FI": true, "FR": true, "GB": true, "ID": true, "IE": true, "IL": true,
+	"IN": true, "IT": true, "JP": true, "KR": true, "LU": true, "MX": true,
+	"NL": true, "NO": true, "NZ": true, "PL": true, "PT": true, "RU": true,
+	"SE": true, "SG": true, "TH": true, "TR": true, "UA": true, "US": true,
 }
 
 // ValidateCountryCode checks if a country code is supported
@@ -121,7 +128,7 @@ func ValidateCountryCode(code string) error {
 		return NewCountryError(ErrInvalidCountry, code, "country code cannot be empty")
 	}
 
-	if !SupportedCountries[code] {
+	if !IsValidCountry(code) {
 		return NewCountryError(ErrInvalidCountry, code,
 			fmt.Sprintf("country code '%s' is not supported", code))
 	}
@@ -169,17 +176,31 @@ func WrapContextError(err error) error {
 	return err
 }
 
-// IsValidCountry checks if a country code is supported
+// IsValidCountry checks if a country code is supported, by a built-in or registered
+// provider or an embedded dataset
 func IsValidCountry(countryCode string) bool {
-	return SupportedCountries[countryCode]
+	if SupportedCountries[countryCode] || isRegistered(countryCode) {
+		return true
+	}
+	_, exists := embeddedProvider(countryCode)
+	return exists
 }
 
-// GetSupportedCountries returns a list of all supported country codes
+// GetSupportedCountries returns a list of all supported country codes, including those
+// added with RegisterProvider or RegisterProviderFactory
 func GetSupportedCountries() []string {
-	countries := make([]string, 0, len(SupportedCountries))
+	registeredProvidersMu.RLock()
+	defer registeredProvidersMu.RUnlock()
+
+	countries := make([]string, 0, len(SupportedCountries)+len(registeredProviders))
 	for code := range SupportedCountries {
 		countries = append(countries, code)
 	}
+	for code := range registeredProviders {
+		if !SupportedCountries[code] {
+			countries = append(countries, code)
+		}
+	}
 	return countries
 }
 
@@ -196,6 +217,10 @@ const (
 	CategoryHalfDay     HolidayCategory = "half_day"
 	CategoryArmedForces HolidayCategory = "armed_forces"
 	CategoryWorkday     HolidayCategory = "workday"
+
+	// CategoryFederal is used by federal countries such as the US and Switzerland for
+	// nationwide holidays. It counts as CategoryPublic: filtering by either keeps them.
+	CategoryFederal HolidayCategory = "federal"
 )
 
 // Holiday represents a single holiday with its properties
@@ -206,47 +231,176 @@ type Holiday struct {
 	Observed   *time.Time        `json:"observed,omitempty"`
 	Languages  map[string]string `json:"languages,omitempty"`
 	IsObserved bool              `json:"is_observed"`
+
+	// Subdivisions lists the subdivisions a regional holiday applies to; it is empty
+	// for holidays observed nationwide
+	Subdivisions []string `json:"subdivisions,omitempty"`
+
+	// SubstituteFor names the holiday a substitute day replaces; HasSubstitute marks
+	// the original holiday, whose Observed date then points at the substitute day
+	SubstituteFor string `json:"substitute_for,omitempty"`
+	HasSubstitute bool   `json:"has_substitute,omitempty"`
+
+	// Sources lists the codes of the countries a holiday of a combined calendar comes
+	// from; it is empty for the holidays of a single country
+	Sources []string `json:"sources,omitempty"`
+
+	// FromYear and ToYear are the first and last years the holiday is observed, such as
+	// 1983 for Martin Luther King Jr. Day; zero leaves that end open. A holiday is not
+	// loaded for a year outside them.
+	FromYear int `json:"from_year,omitempty"`
+	ToYear   int `json:"to_year,omitempty"`
+}
+
+// InEffect reports whether the holiday is observed in year, according to FromYear and ToYear
+func (h *Holiday) InEffect(year int) bool {
+	return (h.FromYear == 0 || year >= h.FromYear) && (h.ToYear == 0 || year <= h.ToYear)
+}
+
+// LanguageName is a holiday name in a single language
+type LanguageName struct {
+	Lang string
+	Name string
+}
+
+// SortedLanguages returns the holiday's translations ordered by language code
+func (h *Holiday) SortedLanguages() []LanguageName {
+	names := make([]LanguageName, 0, len(h.Languages))
+	for lang, name := range h.Languages {
+		names = append(names, LanguageName{Lang: lang, Name: name})
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return names[i].Lang < names[j].Lang
+	})
+	return names
 }
 
 // Country represents a country's holiday provider with thread-safe caching
 type Country struct {
-	code         string
-	subdivisions []string
-	years        map[int]map[time.Time]*Holiday
-	categories   []HolidayCategory
-	language     string
-	mu           sync.RWMutex // Protects concurrent access to years map
+	countrySettings
+	years  map[int]map[time.Time]*Holiday
+	extras map[int]map[time.Time][]*Holiday // Further holidays sharing a date with the one in years
+	cache  yearCache                        // Tracks use of the loaded years for eviction
+	mu     sync.RWMutex                     // Protects concurrent access to years map
+}
+
+// countrySettings holds everything that determines a Country's holidays, as opposed to
+// the years loaded from it, so a Country can be cloned without its loaded state
+type countrySettings struct {
+	code             string
+	subdivisions     []string
+	categories       []HolidayCategory
+	filterCategories bool // Set when categories were configured explicitly
+	includeOptional  bool
+	solidarityDay    bool // FR: Whit Monday is worked as the journée de solidarité
+	checkLanguages   bool // Log holidays loaded without a name in the default language
+	checkCount       bool // Log years loaded with fewer holidays than the provider expects
+	collisionRule    CollisionRule
+	observance       ObservanceRule
+	lookahead        int                   // Years searched past the start date for the next holiday
+	recurring        []recurringHoliday    // Registered with AddRecurringHoliday
+	customEdits      []customEdit          // Made with AddCustomHoliday and RemoveHoliday, in order
+	sources          []*Country            // Set by Combine: the countries whose holidays are united
+	snapshot         map[int][]*Holiday    // Set by LoadFromJSON and LoadFromCSV: the only holidays served, by year
+	configManager    *config.ConfigManager // Set by NewCountryFromConfig: renames, exclusions and custom holidays
+	language         string
+	languageFallback []string // Set by SetLanguageFallback: tried after the requested language
 }
 
 // CountryOptions provides configuration options for creating a Country
 type CountryOptions struct {
+	// Subdivisions adds the regional holidays of each listed subdivision, e.g. {"CA", "TX"}
+	// for the union of California's and Texas's holidays
 	Subdivisions []string
 	Categories   []HolidayCategory
 	Language     string
 	Years        []int
+	// IncludeOptional keeps CategoryOptional holidays, which are excluded by default
+	IncludeOptional bool
+	// CollisionRule overrides the country's rule for holidays that fall on the same date
+	CollisionRule CollisionRule
+	// ObservanceRule shifts holidays falling on a weekend to an observed weekday
+	ObservanceRule ObservanceRule
+	// NextHolidayLookahead is how many years past (or before) the start date NextHoliday,
+	// PreviousHoliday and DaysUntilNextHoliday search; zero uses the default of 5
+	NextHolidayLookahead int
+	// MaxCachedYears bounds how many years stay loaded, evicting the least recently used
+	// year beyond it; zero keeps every year. A server answering queries for arbitrary
+	// years can set it from the config package's PerformanceConfig.MaxCacheSize.
+	MaxCachedYears int
+	// SolidarityDay treats France's Whit Monday as the journée de solidarité, a day many
+	// employers work: it is filed as CategoryOptional, so it is a business day unless
+	// IncludeOptional is also set. It has no effect for other countries.
+	SolidarityDay bool
+	// CheckLanguages logs a warning for each loaded holiday without a name in
+	// countries.DefaultLanguage, the language displays fall back to. It catches provider
+	// data missing translations; holidays are still returned unchanged.
+	CheckLanguages bool
+	// CheckHolidayCount logs a warning when a year loads fewer holidays than the
+	// provider's ExpectedHolidayCount, a sign of sparse or missing data. Only the GB, CA
+	// and AU providers describe an expected count.
+	CheckHolidayCount bool
+}
+
+// CollisionRule controls whether a holiday that falls on another holiday is given a substitute day
+type CollisionRule int
+
+const (
+	// CollisionCountryDefault applies the country's own rule
+	CollisionCountryDefault CollisionRule = iota
+	// CollisionNoSubstitute leaves colliding holidays sharing their date
+	CollisionNoSubstitute
+	// CollisionNextWeekday gives every holiday after the first on a date a substitute
+	// day on the next weekday that is not already a holiday
+	CollisionNextWeekday
+)
+
+// defaultCollisionRules lists the countries that grant substitute days for colliding holidays
+var defaultCollisionRules = map[string]CollisionRule{
+	"KR": CollisionNextWeekday,
 }
 
 // NewCountry creates a new Country holiday provider
 // Note: For error handling, use NewCountryWithError instead
 func NewCountry(countryCode string, options ...CountryOptions) *Country {
 	c := &Country{
-		code:       countryCode,
-		years:      make(map[int]map[time.Time]*Holiday),
-		categories: []HolidayCategory{CategoryPublic},
-		language:   "en",
+		countrySettings: countrySettings{
+			code:       countryCode,
+			categories: []HolidayCategory{CategoryPublic},
+			language:   "en",
+			lookahead:  defaultNextHolidayLookahead,
+		},
+		years:  make(map[int]map[time.Time]*Holiday),
+		extras: make(map[int]map[time.Time][]*Holiday),
 	}
+	c.collisionRule = defaultCollisionRules[countryCode]
 
 	if len(options) > 0 {
 		opt := options[0]
 		if opt.Subdivisions != nil {
 			c.subdivisions = opt.Subdivisions
 		}
-		if opt.Categories != nil {
+		if len(opt.Categories) > 0 {
 			c.categories = opt.Categories
+			c.filterCategories = true
 		}
 		if opt.Language != "" {
 			c.language = opt.Language
 		}
+		c.includeOptional = opt.IncludeOptional
+		c.solidarityDay = opt.SolidarityDay
+		c.checkLanguages = opt.CheckLanguages
+		c.checkCount = opt.CheckHolidayCount
+		if opt.CollisionRule != CollisionCountryDefault {
+			c.collisionRule = opt.CollisionRule
+		}
+		c.observance = opt.ObservanceRule
+		if opt.MaxCachedYears > 0 {
+			c.cache.maxYears = opt.MaxCachedYears
+		}
+		if opt.NextHolidayLookahead > 0 {
+			c.lookahead = opt.NextHolidayLookahead
+		}
 		if opt.Years != nil {
 			c.loadYears(opt.Years)
 		}
@@ -255,6 +409,31 @@ func NewCountry(countryCode string, options ...CountryOptions) *Country {
 	return c
 }
 
+var (
+	defaultCountries   = make(map[string]*Country)
+	defaultCountriesMu sync.Mutex
+)
+
+// Default returns a process-wide shared Country for the given code, created with
+// default options and pre-loaded for the previous, current and next year.
+// The returned Country is shared between callers and must be treated as read-only;
+// use NewCountry when different options are needed.
+func Default(code string) *Country {
+	defaultCountriesMu.Lock()
+	defer defaultCountriesMu.Unlock()
+
+	if c, exists := defaultCountries[code]; exists {
+		return c
+	}
+
+	currentYear := time.Now().Year()
+	c := NewCountry(code, CountryOptions{
+		Years: []int{currentYear - 1, currentYear, currentYear + 1},
+	})
+	defaultCountries[code] = c
+	return c
+}
+
 // IsHoliday checks if the given date is a holiday (thread-safe)
 func (c *Country) IsHoliday(date time.Time) (*Holiday, bool) {
 	year := date.Year()
@@ -262,51 +441,162 @@ func (c *Country) IsHoliday(date time.Time) (*Holiday, bool) {
 	// First, try to read with read lock
 	c.mu.RLock()
 	holidays, exists := c.years[year]
+	if exists {
+		c.cacheHit(year)
+	}
 	c.mu.RUnlock()
 
-	if exists {
-		// Normalize date to compare only year, month, day
-		dateKey := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
-		if holiday, found := holidays[dateKey]; found {
-			return holiday, true
-		}
-	} else {
+	if !exists {
 		// Load holidays for this year if not already loaded
-		c.loadYear(year)
-		return c.IsHoliday(date)
+		holidays = c.loadYear(year)
+	}
+
+	// Normalize date to compare only year, month, day
+	dateKey := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if holiday, found := holidays[dateKey]; found {
+		return holiday, true
 	}
 	return nil, false
 }
 
-// HolidaysForYear returns all holidays for a specific year (thread-safe)
-func (c *Country) HolidaysForYear(year int) map[time.Time]*Holiday {
+// HolidaysOn returns every holiday falling on the given calendar date, starting with
+// the one IsHoliday reports. They are in priority order: national holidays before
+// regional ones, then public before government, bank, armed forces, religious, school,
+// half-day, optional and workday holidays; holidays of equal priority keep the order they
+// were loaded in. It returns nil when the date is not a holiday.
+func (c *Country) HolidaysOn(date time.Time) []*Holiday {
+	loaded, loadedExtras := c.loadYearWithExtras(date.Year())
+
 	c.mu.RLock()
-	holidays, exists := c.years[year]
-	c.mu.RUnlock()
+	defer c.mu.RUnlock()
 
-	if exists {
-		// Return a copy to prevent external modification
-		result := make(map[time.Time]*Holiday, len(holidays))
-		for k, v := range holidays {
-			result[k] = v
+	dateKey := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	holiday, found := loaded[dateKey]
+	if !found {
+		return nil
+	}
+
+	extras := loadedExtras[dateKey]
+	holidays := make([]*Holiday, 0, 1+len(extras))
+	holidays = append(holidays, holiday)
+	return append(holidays, extras...)
+}
+
+// DuplicateWarning reports a date on which several holidays were loaded
+type DuplicateWarning struct {
+	Date  time.Time
+	Names []string // The first is the holiday IsHoliday returns
+}
+
+// DetectDuplicates returns the dates of year that carry more than one holiday, sorted by
+// date. Distinct holidays may genuinely coincide, but two names for what is really one
+// holiday usually means two code paths added it.
+func (c *Country) DetectDuplicates(year int) []DuplicateWarning {
+	loaded, loadedExtras := c.loadYearWithExtras(year)
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var warnings []DuplicateWarning
+	for date, extras := range loadedExtras {
+		if len(extras) == 0 {
+			continue
+		}
+		names := []string{loaded[date].Name}
+		for _, holiday := range extras {
+			names = append(names, holiday.Name)
 		}
-		return result
+		warnings = append(warnings, DuplicateWarning{Date: date, Names: names})
 	}
 
-	c.loadYear(year)
+	sort.Slice(warnings, func(i, j int) bool {
+		return warnings[i].Date.Before(warnings[j].Date)
+	})
+	return warnings
+}
+
+// HolidaysForYear returns all holidays for a specific year (thread-safe)
+func (c *Country) HolidaysForYear(year int) map[time.Time]*Holiday {
+	holidays := c.loadYear(year)
 
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
 	// Return a copy to prevent external modification
-	result := make(map[time.Time]*Holiday, len(c.years[year]))
-	for k, v := range c.years[year] {
+	result := make(map[time.Time]*Holiday, len(holidays))
+	for k, v := range holidays {
 		result[k] = v
 	}
 	return result
 }
 
-// HolidaysForDateRange returns all holidays within a date range
+// SortedHolidaysForYear returns all holidays for a specific year ordered by date
+func (c *Country) SortedHolidaysForYear(year int) []*Holiday {
+	return sortedByDate(c.HolidaysForYear(year))
+}
+
+// sortedByDate returns the holidays of a date-keyed map ordered by date
+func sortedByDate(holidays map[time.Time]*Holiday) []*Holiday {
+	dates := make([]time.Time, 0, len(holidays))
+	for date := range holidays {
+		dates = append(dates, date)
+	}
+	sort.Slice(dates, func(i, j int) bool {
+		return dates[i].Before(dates[j])
+	})
+
+	sorted := make([]*Holiday, len(dates))
+	for i, date := range dates {
+		sorted[i] = holidays[date]
+	}
+	return sorted
+}
+
+// HolidayDates returns every date the named holiday occupies in year, sorted: the actual
+// date, its observed date when shifted, and any substitute day granted for it
+func (c *Country) HolidayDates(year int, name string) []time.Time {
+	loaded, loadedExtras := c.loadYearWithExtras(year)
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	seen := make(map[time.Time]bool)
+	collect := func(holiday *Holiday) {
+		switch {
+		case holiday.Name == name:
+			seen[holiday.Date] = true
+			if holiday.Observed != nil {
+				seen[*holiday.Observed] = true
+			}
+		case holiday.SubstituteFor == name:
+			seen[holiday.Date] = true
+		}
+	}
+	for _, holiday := range loaded {
+		collect(holiday)
+	}
+	for _, extras := range loadedExtras {
+		for _, holiday := range extras {
+			collect(holiday)
+		}
+	}
+
+	dates := make([]time.Time, 0, len(seen))
+	for date := range seen {
+		dates = append(dates, date)
+	}
+	sort.Slice(dates, func(i, j int) bool {
+		return dates[i].Before(dates[j])
+	})
+	return dates
+}
+
+// HolidaysForDateRange returns all holidays within a date range. The range is closed:
+// holidays on start and on end are both included. Use HolidaysForDateRangeExclusiveEnd
+// for a half-open range.
 func (c *Country) HolidaysForDateRange(start, end time.Time) map[time.Time]*Holiday {
 	result := make(map[time.Time]*Holiday)
 
@@ -325,6 +615,71 @@ func (c *Country) HolidaysForDateRange(start, end time.Time) map[time.Time]*Holi
 	return result
 }
 
+// HolidaysForDateRangeExclusiveEnd returns all holidays within the half-open range
+// [start, end): holidays on start are included, holidays on end are not. This suits
+// ranges built from period boundaries, such as a month from its first day to the first
+// day of the next.
+func (c *Country) HolidaysForDateRangeExclusiveEnd(start, end time.Time) map[time.Time]*Holiday {
+	holidays := c.HolidaysForDateRange(start, end)
+	for date := range holidays {
+		if !date.Before(end) {
+			delete(holidays, date)
+		}
+	}
+	return holidays
+}
+
+// SortedHolidaysForDateRange returns all holidays within a date range ordered by date
+func (c *Country) SortedHolidaysForDateRange(start, end time.Time) []*Holiday {
+	return sortedByDate(c.HolidaysForDateRange(start, end))
+}
+
+// IterateHolidays calls fn for each holiday within a date range, in date order, stopping
+// early when fn returns false. Years are loaded one at a time as the iteration reaches
+// them, so no result for the whole range is built; combine it with
+// CountryOptions.MaxCachedYears to keep memory flat over ranges spanning decades.
+func (c *Country) IterateHolidays(start, end time.Time, fn func(date time.Time, h *Holiday) bool) {
+	for year := start.Year(); year <= end.Year(); year++ {
+		for _, holiday := range sortedByDate(c.HolidaysForYear(year)) {
+			if holiday.Date.Before(start) {
+				continue
+			}
+			if holiday.Date.After(end) {
+				return
+			}
+			if !fn(holiday.Date, holiday) {
+				return
+			}
+		}
+	}
+}
+
+// HolidaysForFiscalYear returns all holidays in the 12 months beginning on the first of
+// startMonth in fiscalYear, e.g. April 2024 to March 2025 for April and 2024. Fiscal
+// years named after the year they end in, such as the US federal one, pass the previous
+// year. A startMonth outside January to December returns no holidays.
+func (c *Country) HolidaysForFiscalYear(startMonth time.Month, fiscalYear int) map[time.Time]*Holiday {
+	start, end, ok := fiscalYearRange(startMonth, fiscalYear)
+	if !ok {
+		return map[time.Time]*Holiday{}
+	}
+	return c.HolidaysForDateRange(start, end)
+}
+
+// SortedHolidaysForFiscalYear returns the holidays of HolidaysForFiscalYear ordered by date
+func (c *Country) SortedHolidaysForFiscalYear(startMonth time.Month, fiscalYear int) []*Holiday {
+	return sortedByDate(c.HolidaysForFiscalYear(startMonth, fiscalYear))
+}
+
+// fiscalYearRange returns the first and last day of a fiscal year
+func fiscalYearRange(startMonth time.Month, fiscalYear int) (time.Time, time.Time, bool) {
+	if startMonth < time.January || startMonth > time.December {
+		return time.Time{}, time.Time{}, false
+	}
+	start := time.Date(fiscalYear, startMonth, 1, 0, 0, 0, 0, time.UTC)
+	return start, start.AddDate(1, 0, -1), true
+}
+
 // GetCountryCode returns the country code
 func (c *Country) GetCountryCode() string {
 	return c.code
@@ -345,25 +700,113 @@ func (c *Country) GetLanguage() string {
 	return c.language
 }
 
-// loadYear loads holidays for a specific year (thread-safe)
-func (c *Country) loadYear(year int) {
+// AvailableLanguages returns the language codes that the year's holidays carry
+// translations for, sorted
+func (c *Country) AvailableLanguages(year int) []string {
+	loaded, loadedExtras := c.loadYearWithExtras(year)
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	seen := make(map[string]bool)
+	for _, holiday := range loaded {
+		for lang := range holiday.Languages {
+			seen[lang] = true
+		}
+	}
+	for _, extras := range loadedExtras {
+		for _, holiday := range extras {
+			for lang := range holiday.Languages {
+				seen[lang] = true
+			}
+		}
+	}
+
+	languages := make([]string, 0, len(seen))
+	for lang := range seen {
+		languages = append(languages, lang)
+	}
+	sort.Strings(languages)
+	return languages
+}
+
+// dataVersionYears are the sample years hashed by DataVersion
+var dataVersionYears = []int{1990, 2000, 2010, 2020, 2030}
+
+// DataVersion identifies the holiday data this Country produces, as the library Version
+// followed by a revision hash of the holidays generated for a fixed set of sample years.
+// The revision changes whenever the underlying holiday definitions or the options that
+// shape them change, which makes it suitable as a cache key.
+func (c *Country) DataVersion() string {
+	hash := fnv.New64a()
+	for _, year := range dataVersionYears {
+		holidays := c.HolidaysForYear(year)
+
+		dates := make([]time.Time, 0, len(holidays))
+		for date := range holidays {
+			dates = append(dates, date)
+		}
+		sort.Slice(dates, func(i, j int) bool { return dates[i].Before(dates[j]) })
+
+		for _, date := range dates {
+			holiday := holidays[date]
+			fmt.Fprintf(hash, "%s|%s|%s\n", date.Format("2006-01-02"), holiday.Name, holiday.Category)
+		}
+	}
+
+	return fmt.Sprintf("%s+%s.%x", Version, c.code, hash.Sum64())
+}
+
+// loadYear loads holidays for a specific year (thread-safe) and returns them, as the
+// year may be evicted again by the time the caller reads c.years
+func (c *Country) loadYear(year int) map[time.Time]*Holiday {
+	holidays, _ := c.loadYearWithExtras(year)
+	return holidays
+}
+
+// loadYearWithExtras loads holidays for a specific year like loadYear, also returning the
+// further holidays sharing their dates. Both are read under the same lock, so an eviction
+// in between cannot leave the caller with one but not the other. Callers read the
+// returned maps under c.mu.RLock, as custom edits may still change them.
+func (c *Country) loadYearWithExtras(year int) (map[time.Time]*Holiday, map[time.Time][]*Holiday) {
 	// Double-checked locking pattern for performance
 	c.mu.RLock()
-	_, exists := c.years[year]
+	holidays, exists := c.years[year]
+	extras := c.extras[year]
+	if exists {
+		c.cacheHit(year)
+	}
 	c.mu.RUnlock()
 
 	if exists {
-		return // Already loaded
+		return holidays, extras // Already loaded
 	}
 
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
 	// Check again after acquiring write lock
-	if c.years[year] == nil {
-		c.years[year] = make(map[time.Time]*Holiday)
-		c.loadCountryHolidays(year)
+	if c.years[year] != nil {
+		c.cacheHit(year)
+	} else {
+		c.populateYear(year)
 	}
+	return c.years[year], c.extras[year]
+}
+
+// populateYear runs the load pipeline for a year that is not loaded (caller must hold the
+// write lock)
+func (c *Country) populateYear(year int) {
+	c.years[year] = make(map[time.Time]*Holiday)
+	c.loadCountryHolidays(year)
+	c.applyCategoryFilter(year)
+	c.applyRecurringHolidays(year)
+	c.applyConfigHolidays(year)
+	c.applyCustomEdits(year)
+	c.applyCollisionRule(year)
+	c.applyObservanceRule(year)
+	c.warnIfMissingLanguage(year)
+	c.cacheLoaded(year)
 }
 
 // loadYears loads holidays for multiple years
@@ -375,6 +818,21 @@ func (c *Country) loadYears(years []int) {
 
 // loadCountryHolidays loads country-specific holidays using the countries package
 func (c *Country) loadCountryHolidays(year int) {
+	if len(c.sources) > 0 {
+		c.loadCombinedHolidays(year)
+		return
+	}
+	if c.snapshot != nil {
+		c.loadSnapshotHolidays(year)
+		return
+	}
+
+	// A registered provider overrides the built-in holidays
+	if provider, exists := registeredProvider(c.code); exists {
+		c.loadProviderHolidays(year, provider)
+		return
+	}
+
 	// Load holidays using the appropriate country provider
 	switch c.code {
 	case "US":
@@ -417,181 +875,374 @@ func (c *Country) loadCountryHolidays(year int) {
 		c.loadILHolidays(year)
 	// Add more countries as needed
 	default:
-		// Load from generic holiday data or return empty
+		// Fall back to a provider added with RegisterProvider or an embedded dataset, or return empty
+		c.loadRegisteredHolidays(year)
 	}
+
+	c.loadSubdivisionHolidays(year)
 }
 
-// loadUSHolidays loads US holidays using the US provider
-func (c *Country) loadUSHolidays(year int) {
-	provider := countries.NewUSProvider()
-	holidayMap := provider.LoadHolidays(year)
+// subdivisionProviders supplies the subdivision-specific holidays of the countries loaded above
+var subdivisionProviders = map[string]func() countries.SubdivisionHolidayProvider{
+	"AU": func() countries.SubdivisionHolidayProvider { return countries.NewAUProvider() },
+	"CA": func() countries.SubdivisionHolidayProvider { return countries.NewCAProvider() },
+	"DE": func() countries.SubdivisionHolidayProvider { return countries.NewDEProvider() },
+	"FR": func() countries.SubdivisionHolidayProvider { return countries.NewFRProvider() },
+	"GB": func() countries.SubdivisionHolidayProvider { return countries.NewGBProvider() },
+	"IN": func() countries.SubdivisionHolidayProvider { return countries.NewINProvider() },
+	"IT": func() countries.SubdivisionHolidayProvider { return countries.NewITProvider() },
+	"NZ": func() countries.SubdivisionHolidayProvider { return countries.NewNZProvider() },
+	"US": func() countries.SubdivisionHolidayProvider { return countries.NewUSProvider() },
+}
+
+// loadSubdivisionHolidays adds the holidays of the configured subdivisions on top of the
+// national ones, as their union. Each subdivision is loaded on its own, so regional
+// holidays of different subdivisions on the same date are all kept: one observed under
+// the same name in several subdivisions is a single holiday listing all of them in
+// Subdivisions, while differently named ones rank in the order the subdivisions were
+// given. A national holiday keeps its date ahead of any regional one. Duplicate and
+// unknown subdivision codes contribute nothing.
+func (c *Country) loadSubdivisionHolidays(year int) {
+	newProvider, exists := subdivisionProviders[c.code]
+	if !exists || len(c.subdivisions) == 0 {
+		return
+	}
+
+	provider := newProvider()
+	national := provider.LoadHolidays(year)
+	seen := make(map[string]bool)
+	for _, subdivision := range c.subdivisions {
+		if seen[subdivision] {
+			continue
+		}
+		seen[subdivision] = true
 
-	for date, holiday := range holidayMap {
-		c.years[year][date] = &Holiday{
-			Name:       holiday.Name,
-			Date:       holiday.Date,
-			Category:   HolidayCategory(holiday.Category),
-			Languages:  holiday.Languages,
-			Observed:   holiday.Observed,
-			IsObserved: holiday.IsObserved,
+		for date, holiday := range provider.LoadHolidaysForSubdivisions(year, []string{subdivision}) {
+			if nationalHoliday, isNational := national[date]; isNational && nationalHoliday.Name == holiday.Name {
+				continue
+			}
+			c.addSubdivisionHoliday(year, subdivision, fromProviderHoliday(*holiday))
 		}
 	}
 }
 
-func (c *Country) loadGBHolidays(year int) {
-	holidays := c.years[year]
+// addSubdivisionHoliday stores a holiday of subdivision, merging it into a regional
+// holiday of the same name already loaded on its date for another subdivision (caller
+// must hold the write lock)
+func (c *Country) addSubdivisionHoliday(year int, subdivision string, holiday *Holiday) {
+	subdivisions := holiday.Subdivisions
+	if len(subdivisions) == 0 {
+		subdivisions = []string{subdivision}
+	}
+	holiday.Subdivisions = appendMissing(nil, subdivisions...)
+
+	date := time.Date(holiday.Date.Year(), holiday.Date.Month(), holiday.Date.Day(), 0, 0, 0, 0, time.UTC)
+	existing := append([]*Holiday{c.years[year][date]}, c.extras[year][date]...)
+	for _, other := range existing {
+		if other != nil && other.Name == holiday.Name && len(other.Subdivisions) > 0 {
+			other.Subdivisions = appendMissing(other.Subdivisions, holiday.Subdivisions...)
+			return
+		}
+	}
+	c.addHoliday(year, holiday)
+}
 
-	// New Year's Day
-	holidays[time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)] = &Holiday{
-		Name:     "New Year's Day",
-		Date:     time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC),
-		Category: CategoryPublic,
-		Languages: map[string]string{
-			"en": "New Year's Day",
-		},
+// categoryAliases maps provider-specific categories onto the standard category they belong to
+var categoryAliases = map[HolidayCategory]HolidayCategory{
+	CategoryFederal: CategoryPublic,
+	"national":      CategoryPublic,
+	"orthodox":      CategoryReligious,
+}
+
+// canonicalCategory returns the standard category a provider-specific category belongs to
+func canonicalCategory(category HolidayCategory) HolidayCategory {
+	if alias, exists := categoryAliases[category]; exists {
+		return alias
 	}
+	return category
+}
 
-	// Christmas Day
-	holidays[time.Date(year, 12, 25, 0, 0, 0, 0, time.UTC)] = &Holiday{
-		Name:     "Christmas Day",
-		Date:     time.Date(year, 12, 25, 0, 0, 0, 0, time.UTC),
-		Category: CategoryPublic,
-		Languages: map[string]string{
-			"en": "Christmas Day",
-		},
+// includesCategory reports whether holidays of the given category should be kept
+func (c *Country) includesCategory(category HolidayCategory) bool {
+	if !c.filterCategories {
+		return category != CategoryOptional || c.includeOptional
 	}
+
+	canonical := canonicalCategory(category)
+	for _, configured := range c.categories {
+		if configured == category || configured == canonical {
+			return true
+		}
+	}
+	return category == CategoryOptional && c.includeOptional
 }
 
-func (c *Country) loadCAHolidays(year int) {
-	holidays := c.years[year]
+// applyCategoryFilter removes loaded holidays whose category is not configured (caller must hold the write lock)
+func (c *Country) applyCategoryFilter(year int) {
+	c.filterHolidays(year, func(holiday *Holiday) bool {
+		return c.includesCategory(holiday.Category)
+	})
+}
 
-	// New Year's Day
-	holidays[time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)] = &Holiday{
-		Name:     "New Year's Day",
-		Date:     time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC),
-		Category: CategoryPublic,
-		Languages: map[string]string{
-			"en": "New Year's Day",
-			"fr": "Jour de l'An",
-		},
+// filterHolidays removes the loaded holidays of a year that keep rejects, promoting the
+// next holiday on the same date in place of a removed one (caller must hold the write lock)
+func (c *Country) filterHolidays(year int, keep func(*Holiday) bool) {
+	for date, extras := range c.extras[year] {
+		kept := extras[:0]
+		for _, holiday := range extras {
+			if keep(holiday) {
+				kept = append(kept, holiday)
+			}
+		}
+		c.extras[year][date] = kept
 	}
 
-	// Canada Day
-	holidays[time.Date(year, 7, 1, 0, 0, 0, 0, time.UTC)] = &Holiday{
-		Name:     "Canada Day",
-		Date:     time.Date(year, 7, 1, 0, 0, 0, 0, time.UTC),
-		Category: CategoryPublic,
-		Languages: map[string]string{
-			"en": "Canada Day",
-			"fr": "Fête du Canada",
-		},
+	for date, holiday := range c.years[year] {
+		if keep(holiday) {
+			continue
+		}
+		// Promote the next holiday on the same date, if any
+		if extras := c.extras[year][date]; len(extras) > 0 {
+			c.years[year][date] = extras[0]
+			c.extras[year][date] = extras[1:]
+		} else {
+			delete(c.years[year], date)
+		}
 	}
 
-	// Christmas Day
-	holidays[time.Date(year, 12, 25, 0, 0, 0, 0, time.UTC)] = &Holiday{
-		Name:     "Christmas Day",
-		Date:     time.Date(year, 12, 25, 0, 0, 0, 0, time.UTC),
-		Category: CategoryPublic,
-		Languages: map[string]string{
-			"en": "Christmas Day",
-			"fr": "Noël",
-		},
+	for date, extras := range c.extras[year] {
+		if len(extras) == 0 {
+			delete(c.extras[year], date)
+		}
 	}
+}
 
-	// Thanksgiving Day - 2nd Monday in October
-	thanksgiving := c.getNthWeekdayOfMonth(year, 10, time.Monday, 2)
-	holidays[thanksgiving] = &Holiday{
-		Name:     "Thanksgiving Day",
-		Date:     thanksgiving,
-		Category: CategoryPublic,
-		Languages: map[string]string{
-			"en": "Thanksgiving Day",
-			"fr": "Action de grâce",
-		},
+// applyCollisionRule adds substitute days for holidays that share a date with another
+// holiday, when the country's collision rule asks for them (caller must hold the write
+// lock). Substitutes that would fall after the end of the year are not added.
+func (c *Country) applyCollisionRule(year int) {
+	if c.collisionRule != CollisionNextWeekday || len(c.extras[year]) == 0 {
+		return
+	}
+
+	dates := make([]time.Time, 0, len(c.extras[year]))
+	for date := range c.extras[year] {
+		dates = append(dates, date)
+	}
+	sort.Slice(dates, func(i, j int) bool {
+		return dates[i].Before(dates[j])
+	})
+
+	for _, date := range dates {
+		for _, holiday := range c.extras[year][date] {
+			substitute := date.AddDate(0, 0, 1)
+			for {
+				_, taken := c.years[year][substitute]
+				if !taken && substitute.Weekday() != time.Saturday && substitute.Weekday() != time.Sunday {
+					break
+				}
+				substitute = substitute.AddDate(0, 0, 1)
+			}
+			if substitute.Year() != year {
+				continue
+			}
+
+			name := holiday.Name + " (substitute day)"
+			c.years[year][substitute] = &Holiday{
+				Name:          name,
+				Date:          substitute,
+				Category:      holiday.Category,
+				Languages:     map[string]string{"en": name},
+				SubstituteFor: holiday.Name,
+			}
+
+			observed := substitute
+			holiday.Observed = &observed
+			holiday.IsObserved = true
+			holiday.HasSubstitute = true
+		}
 	}
 }
 
-func (c *Country) loadAUHolidays(year int) {
-	holidays := c.years[year]
+// fromProviderHoliday converts a holiday returned by a countries provider into a Holiday
+func fromProviderHoliday(holiday countries.Holiday) *Holiday {
+	return &Holiday{
+		Name:          holiday.Name,
+		Date:          holiday.Date,
+		Category:      HolidayCategory(holiday.Category),
+		Observed:      holiday.Observed,
+		Languages:     holiday.Languages,
+		IsObserved:    holiday.IsObserved,
+		Subdivisions:  holiday.Subdivisions,
+		SubstituteFor: holiday.SubstituteFor,
+		HasSubstitute: holiday.HasSubstitute,
+		FromYear:      holiday.FromYear,
+		ToYear:        holiday.ToYear,
+	}
+}
 
-	// New Year's Day
-	holidays[time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)] = &Holiday{
-		Name:     "New Year's Day",
-		Date:     time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC),
-		Category: CategoryPublic,
-		Languages: map[string]string{
-			"en": "New Year's Day",
-		},
+// addHoliday stores a holiday for a year being loaded (caller must hold the write lock).
+// The holidays on a date are kept in priority order: the first is the one returned by
+// IsHoliday and HolidaysForYear, and any further holidays with a different name are kept
+// for HolidaysOn. A holiday not in effect that year is skipped.
+func (c *Country) addHoliday(year int, holiday *Holiday) {
+	if !holiday.InEffect(year) {
+		return
 	}
+	date := time.Date(holiday.Date.Year(), holiday.Date.Month(), holiday.Date.Day(), 0, 0, 0, 0, time.UTC)
 
-	// Australia Day
-	holidays[time.Date(year, 1, 26, 0, 0, 0, 0, time.UTC)] = &Holiday{
-		Name:     "Australia Day",
-		Date:     time.Date(year, 1, 26, 0, 0, 0, 0, time.UTC),
-		Category: CategoryPublic,
-		Languages: map[string]string{
-			"en": "Australia Day",
-		},
+	existing, exists := c.years[year][date]
+	if !exists || existing.Name == holiday.Name {
+		c.years[year][date] = holiday
+		return
 	}
 
-	// ANZAC Day
-	holidays[time.Date(year, 4, 25, 0, 0, 0, 0, time.UTC)] = &Holiday{
-		Name:     "ANZAC Day",
-		Date:     time.Date(year, 4, 25, 0, 0, 0, 0, time.UTC),
-		Category: CategoryPublic,
-		Languages: map[string]string{
-			"en": "ANZAC Day",
-		},
+	for i, extra := range c.extras[year][date] {
+		if extra.Name == holiday.Name {
+			c.extras[year][date][i] = holiday
+			return
+		}
 	}
 
-	// Queen's Birthday - 2nd Monday in June (most states)
-	queensBirthday := c.getNthWeekdayOfMonth(year, 6, time.Monday, 2)
-	holidays[queensBirthday] = &Holiday{
-		Name:     "Queen's Birthday",
-		Date:     queensBirthday,
-		Category: CategoryPublic,
-		Languages: map[string]string{
-			"en": "Queen's Birthday",
-		},
+	// Insert after every holiday of equal or higher priority, so ties keep load order
+	holidays := append([]*Holiday{existing}, c.extras[year][date]...)
+	position := len(holidays)
+	for i, other := range holidays {
+		if holidayPriority(holiday) < holidayPriority(other) {
+			position = i
+			break
+		}
 	}
+	holidays = append(holidays[:position], append([]*Holiday{holiday}, holidays[position:]...)...)
 
-	// Labour Day - 1st Monday in October (most states)
-	labourDay := c.getNthWeekdayOfMonth(year, 10, time.Monday, 1)
-	holidays[labourDay] = &Holiday{
-		Name:     "Labour Day",
-		Date:     labourDay,
-		Category: CategoryPublic,
-		Languages: map[string]string{
-			"en": "Labour Day",
-		},
+	if c.extras[year] == nil {
+		c.extras[year] = make(map[time.Time][]*Holiday)
 	}
+	c.years[year][date] = holidays[0]
+	c.extras[year][date] = holidays[1:]
+}
 
-	// Christmas Day
-	holidays[time.Date(year, 12, 25, 0, 0, 0, 0, time.UTC)] = &Holiday{
-		Name:     "Christmas Day",
-		Date:     time.Date(year, 12, 25, 0, 0, 0, 0, time.UTC),
-		Category: CategoryPublic,
-		Languages: map[string]string{
-			"en": "Christmas Day",
-		},
+// categoryPriority orders the categories of holidays sharing a date, most prominent first
+var categoryPriority = []HolidayCategory{
+	CategoryPublic,
+	CategoryGovernment,
+	CategoryBank,
+	CategoryArmedForces,
+	CategoryReligious,
+	CategorySchool,
+	CategoryHalfDay,
+	CategoryOptional,
+	CategoryWorkday,
+}
+
+// holidayPriority ranks a holiday among others on the same date, lower first: national
+// holidays before regional ones, then by category in categoryPriority order
+func holidayPriority(holiday *Holiday) int {
+	rank := len(categoryPriority)
+	for i, category := range categoryPriority {
+		if canonicalCategory(holiday.Category) == category {
+			rank = i
+			break
+		}
 	}
+	if len(holiday.Subdivisions) > 0 {
+		rank += len(categoryPriority) + 1
+	}
+	return rank
+}
 
-	// Boxing Day
-	holidays[time.Date(year, 12, 26, 0, 0, 0, 0, time.UTC)] = &Holiday{
-		Name:     "Boxing Day",
-		Date:     time.Date(year, 12, 26, 0, 0, 0, 0, time.UTC),
-		Category: CategoryPublic,
-		Languages: map[string]string{
-			"en": "Boxing Day",
-		},
+// expectedCountProvider is implemented by providers that describe a lower bound on their yearly holiday count
+type expectedCountProvider interface {
+	ExpectedHolidayCount(year int) int
+}
+
+// warnIfBelowExpected logs a warning when a year loaded fewer holidays than the provider
+// expects, when CheckHolidayCount is set
+func (c *Country) warnIfBelowExpected(year int, provider expectedCountProvider) {
+	if !c.checkCount {
+		return
+	}
+
+	loaded := len(c.years[year])
+	if expected := provider.ExpectedHolidayCount(year); loaded < expected {
+		log.Printf("WARNING: %s %d loaded %d holidays, expected at least %d", c.code, year, loaded, expected)
 	}
 }
 
-func (c *Country) loadNZHolidays(year int) {
-	holidays := c.years[year]
+// warnIfMissingLanguage logs the holidays of a year being loaded that have no name in the
+// default language, when CheckLanguages is set (caller must hold the write lock)
+func (c *Country) warnIfMissingLanguage(year int) {
+	if !c.checkLanguages {
+		return
+	}
+
+	for _, holiday := range c.years[year] {
+		c.warnHolidayLanguage(year, holiday)
+	}
+	for _, extras := range c.extras[year] {
+		for _, holiday := range extras {
+			c.warnHolidayLanguage(year, holiday)
+		}
+	}
+}
+
+// warnHolidayLanguage logs a holiday without a name in the default language
+func (c *Country) warnHolidayLanguage(year int, holiday *Holiday) {
+	if holiday.Languages[countries.DefaultLanguage] == "" {
+		log.Printf("WARNING: %s %d: %s on %s has no %q name",
+			c.code, year, holiday.Name, holiday.Date.Format("2006-01-02"), countries.DefaultLanguage)
+	}
+}
+
+// loadUSHolidays loads US holidays using the US provider
+func (c *Country) loadUSHolidays(year int) {
+	provider := countries.NewUSProvider()
+	holidayMap := provider.LoadHolidays(year)
+
+	for _, holiday := range holidayMap {
+		c.addHoliday(year, fromProviderHoliday(*holiday))
+	}
+}
+
+// loadGBHolidays loads UK holidays, including substitute days, using the GB provider
+func (c *Country) loadGBHolidays(year int) {
+	provider := countries.NewGBProvider()
+	holidayMap := provider.LoadHolidays(year)
+
+	for _, holiday := range holidayMap {
+		c.addHoliday(year, fromProviderHoliday(*holiday))
+	}
+
+	c.warnIfBelowExpected(year, provider)
+}
+
+// loadCAHolidays loads Canada holidays using the CA provider
+func (c *Country) loadCAHolidays(year int) {
+	provider := countries.NewCAProvider()
+	holidayMap := provider.LoadHolidays(year)
 
+	for _, holiday := range holidayMap {
+		c.addHoliday(year, fromProviderHoliday(*holiday))
+	}
+
+	c.warnIfBelowExpected(year, provider)
+}
+
+// loadAUHolidays loads Australia holidays using the AU provider
+func (c *Country) loadAUHolidays(year int) {
+	provider := countries.NewAUProvider()
+	holidayMap := provider.LoadHolidays(year)
+
+	for _, holiday := range holidayMap {
+		c.addHoliday(year, fromProviderHoliday(*holiday))
+	}
+
+	c.warnIfBelowExpected(year, provider)
+}
+
+func (c *Country) loadNZHolidays(year int) {
 	// New Year's Day
-	holidays[time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)] = &Holiday{
+	c.addHoliday(year, &Holiday{
 		Name:     "New Year's Day",
 		Date:     time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC),
 		Category: CategoryPublic,
@@ -599,10 +1250,10 @@ func (c *Country) loadNZHolidays(year int) {
 			"en": "New Year's Day",
 			"mi": "Te Rā Tau Hou",
 		},
-	}
+	})
 
 	// Day after New Year's Day
-	holidays[time.Date(year, 1, 2, 0, 0, 0, 0, time.UTC)] = &Holiday{
+	c.addHoliday(year, &Holiday{
 		Name:     "Day after New Year's Day",
 		Date:     time.Date(year, 1, 2, 0, 0, 0, 0, time.UTC),
 		Category: CategoryPublic,
@@ -610,10 +1261,10 @@ func (c *Country) loadNZHolidays(year int) {
 			"en": "Day after New Year's Day",
 			"mi": "Te Rā i muri i te Tau Hou",
 		},
-	}
+	})
 
 	// Waitangi Day
-	holidays[time.Date(year, 2, 6, 0, 0, 0, 0, time.UTC)] = &Holiday{
+	c.addHoliday(year, &Holiday{
 		Name:     "Waitangi Day",
 		Date:     time.Date(year, 2, 6, 0, 0, 0, 0, time.UTC),
 		Category: CategoryPublic,
@@ -621,12 +1272,12 @@ func (c *Country) loadNZHolidays(year int) {
 			"en": "Waitangi Day",
 			"mi": "Te Rā o Waitangi",
 		},
-	}
+	})
 
 	// Good Friday (Easter-based)
 	easter := c.easterSunday(year)
 	goodFriday := easter.AddDate(0, 0, -2)
-	holidays[goodFriday] = &Holiday{
+	c.addHoliday(year, &Holiday{
 		Name:     "Good Friday",
 		Date:     goodFriday,
 		Category: CategoryPublic,
@@ -634,11 +1285,11 @@ func (c *Country) loadNZHolidays(year int) {
 			"en": "Good Friday",
 			"mi": "Rārā Pai",
 		},
-	}
+	})
 
 	// Easter Monday
 	easterMonday := easter.AddDate(0, 0, 1)
-	holidays[easterMonday] = &Holiday{
+	c.addHoliday(year, &Holiday{
 		Name:     "Easter Monday",
 		Date:     easterMonday,
 		Category: CategoryPublic,
@@ -646,10 +1297,10 @@ func (c *Country) loadNZHolidays(year int) {
 			"en": "Easter Monday",
 			"mi": "Rā Aranga Rērā",
 		},
-	}
+	})
 
 	// ANZAC Day
-	holidays[time.Date(year, 4, 25, 0, 0, 0, 0, time.UTC)] = &Holiday{
+	c.addHoliday(year, &Holiday{
 		Name:     "ANZAC Day",
 		Date:     time.Date(year, 4, 25, 0, 0, 0, 0, time.UTC),
 		Category: CategoryPublic,
@@ -657,11 +1308,11 @@ func (c *Country) loadNZHolidays(year int) {
 			"en": "ANZAC Day",
 			"mi": "Te Rā o nga Hoia",
 		},
-	}
+	})
 
 	// Queen's Birthday - First Monday in June
 	queensBirthday := c.getNthWeekdayOfMonth(year, 6, time.Monday, 1)
-	holidays[queensBirthday] = &Holiday{
+	c.addHoliday(year, &Holiday{
 		Name:     "Queen's Birthday",
 		Date:     queensBirthday,
 		Category: CategoryPublic,
@@ -669,7 +1320,7 @@ func (c *Country) loadNZHolidays(year int) {
 			"en": "Queen's Birthday",
 			"mi": "Te Rā Whānau o te Kuini",
 		},
-	}
+	})
 
 	// Matariki - Known astronomical dates for certain years
 	matarikiDates := map[int]time.Time{
@@ -685,7 +1336,7 @@ func (c *Country) loadNZHolidays(year int) {
 	}
 
 	if matarikiDate, exists := matarikiDates[year]; exists {
-		holidays[matarikiDate] = &Holiday{
+		c.addHoliday(year, &Holiday{
 			Name:     "Matariki",
 			Date:     matarikiDate,
 			Category: CategoryPublic,
@@ -693,12 +1344,13 @@ func (c *Country) loadNZHolidays(year int) {
 				"en": "Matariki",
 				"mi": "Matariki",
 			},
-		}
+			FromYear: 2022,
+		})
 	}
 
 	// Labour Day - Fourth Monday in October
 	labourDay := c.getNthWeekdayOfMonth(year, 10, time.Monday, 4)
-	holidays[labourDay] = &Holiday{
+	c.addHoliday(year, &Holiday{
 		Name:     "Labour Day",
 		Date:     labourDay,
 		Category: CategoryPublic,
@@ -706,10 +1358,10 @@ func (c *Country) loadNZHolidays(year int) {
 			"en": "Labour Day",
 			"mi": "Te Rā Whakatōhea",
 		},
-	}
+	})
 
 	// Christmas Day
-	holidays[time.Date(year, 12, 25, 0, 0, 0, 0, time.UTC)] = &Holiday{
+	c.addHoliday(year, &Holiday{
 		Name:     "Christmas Day",
 		Date:     time.Date(year, 12, 25, 0, 0, 0, 0, time.UTC),
 		Category: CategoryPublic,
@@ -717,10 +1369,10 @@ func (c *Country) loadNZHolidays(year int) {
 			"en": "Christmas Day",
 			"mi": "Te Rā Kirihimete",
 		},
-	}
+	})
 
 	// Boxing Day
-	holidays[time.Date(year, 12, 26, 0, 0, 0, 0, time.UTC)] = &Holiday{
+	c.addHoliday(year, &Holiday{
 		Name:     "Boxing Day",
 		Date:     time.Date(year, 12, 26, 0, 0, 0, 0, time.UTC),
 		Category: CategoryPublic,
@@ -728,14 +1380,12 @@ func (c *Country) loadNZHolidays(year int) {
 			"en": "Boxing Day",
 			"mi": "Te Rā Pouaka",
 		},
-	}
+	})
 }
 
 func (c *Country) loadJPHolidays(year int) {
-	holidays := c.years[year]
-
 	// New Year's Day (元日, Ganjitsu)
-	holidays[time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)] = &Holiday{
+	c.addHoliday(year, &Holiday{
 		Name:     "New Year's Day",
 		Date:     time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC),
 		Category: CategoryPublic,
@@ -743,11 +1393,11 @@ func (c *Country) loadJPHolidays(year int) {
 			"en": "New Year's Day",
 			"ja": "元日",
 		},
-	}
+	})
 
 	// Coming of Age Day (成人の日, Seijin no Hi) - Second Monday of January
 	comingOfAge := c.getNthWeekdayOfMonth(year, 1, time.Monday, 2)
-	holidays[comingOfAge] = &Holiday{
+	c.addHoliday(year, &Holiday{
 		Name:     "Coming of Age Day",
 		Date:     comingOfAge,
 		Category: CategoryPublic,
@@ -755,10 +1405,10 @@ func (c *Country) loadJPHolidays(year int) {
 			"en": "Coming of Age Day",
 			"ja": "成人の日",
 		},
-	}
+	})
 
 	// National Foundation Day (建国記念の日, Kenkoku Kinen no Hi)
-	holidays[time.Date(year, 2, 11, 0, 0, 0, 0, time.UTC)] = &Holiday{
+	c.addHoliday(year, &Holiday{
 		Name:     "National Foundation Day",
 		Date:     time.Date(year, 2, 11, 0, 0, 0, 0, time.UTC),
 		Category: CategoryPublic,
@@ -766,7 +1416,7 @@ func (c *Country) loadJPHolidays(year int) {
 			"en": "National Foundation Day",
 			"ja": "建国記念の日",
 		},
-	}
+	})
 
 	// Emperor's Birthday (天皇誕生日, Tennō Tanjōbi)
 	var emperorBirthday time.Time
@@ -775,7 +1425,7 @@ func (c *Country) loadJPHolidays(year int) {
 	} else {
 		emperorBirthday = time.Date(year, 12, 23, 0, 0, 0, 0, time.UTC) // Emperor Akihito
 	}
-	holidays[emperorBirthday] = &Holiday{
+	c.addHoliday(year, &Holiday{
 		Name:     "Emperor's Birthday",
 		Date:     emperorBirthday,
 		Category: CategoryPublic,
@@ -783,10 +1433,10 @@ func (c *Country) loadJPHolidays(year int) {
 			"en": "Emperor's Birthday",
 			"ja": "天皇誕生日",
 		},
-	}
+	})
 
 	// Constitution Memorial Day (憲法記念日, Kenpō Kinenbi)
-	holidays[time.Date(year, 5, 3, 0, 0, 0, 0, time.UTC)] = &Holiday{
+	c.addHoliday(year, &Holiday{
 		Name:     "Constitution Memorial Day",
 		Date:     time.Date(year, 5, 3, 0, 0, 0, 0, time.UTC),
 		Category: CategoryPublic,
@@ -794,10 +1444,10 @@ func (c *Country) loadJPHolidays(year int) {
 			"en": "Constitution Memorial Day",
 			"ja": "憲法記念日",
 		},
-	}
+	})
 
 	// Greenery Day (みどりの日, Midori no Hi)
-	holidays[time.Date(year, 5, 4, 0, 0, 0, 0, time.UTC)] = &Holiday{
+	c.addHoliday(year, &Holiday{
 		Name:     "Greenery Day",
 		Date:     time.Date(year, 5, 4, 0, 0, 0, 0, time.UTC),
 		Category: CategoryPublic,
@@ -805,10 +1455,10 @@ func (c *Country) loadJPHolidays(year int) {
 			"en": "Greenery Day",
 			"ja": "みどりの日",
 		},
-	}
+	})
 
 	// Children's Day (こどもの日, Kodomo no Hi)
-	holidays[time.Date(year, 5, 5, 0, 0, 0, 0, time.UTC)] = &Holiday{
+	c.addHoliday(year, &Holiday{
 		Name:     "Children's Day",
 		Date:     time.Date(year, 5, 5, 0, 0, 0, 0, time.UTC),
 		Category: CategoryPublic,
@@ -816,11 +1466,11 @@ func (c *Country) loadJPHolidays(year int) {
 			"en": "Children's Day",
 			"ja": "こどもの日",
 		},
-	}
+	})
 
 	// Marine Day (海の日, Umi no Hi) - Third Monday of July
 	marine := c.getNthWeekdayOfMonth(year, 7, time.Monday, 3)
-	holidays[marine] = &Holiday{
+	c.addHoliday(year, &Holiday{
 		Name:     "Marine Day",
 		Date:     marine,
 		Category: CategoryPublic,
@@ -828,7 +1478,7 @@ func (c *Country) loadJPHolidays(year int) {
 			"en": "Marine Day",
 			"ja": "海の日",
 		},
-	}
+	})
 
 	// Sports Day (スポーツの日, Supōtsu no Hi) - Second Monday of October
 	sports := c.getNthWeekdayOfMonth(year, 10, time.Monday, 2)
@@ -836,7 +1486,7 @@ func (c *Country) loadJPHolidays(year int) {
 	if year < 2020 {
 		sportsName = "Health and Sports Day"
 	}
-	holidays[sports] = &Holiday{
+	c.addHoliday(year, &Holiday{
 		Name:     sportsName,
 		Date:     sports,
 		Category: CategoryPublic,
@@ -844,10 +1494,10 @@ func (c *Country) loadJPHolidays(year int) {
 			"en": sportsName,
 			"ja": "スポーツの日",
 		},
-	}
+	})
 
 	// Culture Day (文化の日, Bunka no Hi)
-	holidays[time.Date(year, 11, 3, 0, 0, 0, 0, time.UTC)] = &Holiday{
+	c.addHoliday(year, &Holiday{
 		Name:     "Culture Day",
 		Date:     time.Date(year, 11, 3, 0, 0, 0, 0, time.UTC),
 		Category: CategoryPublic,
@@ -855,7 +1505,7 @@ func (c *Country) loadJPHolidays(year int) {
 			"en": "Culture Day",
 			"ja": "文化の日",
 		},
-	}
+	})
 }
 
 // getNthWeekdayOfMonth is a helper method for calculating variable holidays
@@ -902,10 +1552,8 @@ func (c *Country) easterSunday(year int) time.Time {
 
 // loadINHolidays loads holidays specific to India
 func (c *Country) loadINHolidays(year int) {
-	holidays := c.years[year]
-
 	// New Year's Day
-	holidays[time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)] = &Holiday{
+	c.addHoliday(year, &Holiday{
 		Name:     "New Year's Day",
 		Date:     time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC),
 		Category: CategoryPublic,
@@ -913,10 +1561,10 @@ func (c *Country) loadINHolidays(year int) {
 			"en": "New Year's Day",
 			"hi": "नव वर्ष दिवस",
 		},
-	}
+	})
 
-	// Republic Day
-	holidays[time.Date(year, 1, 26, 0, 0, 0, 0, time.UTC)] = &Holiday{
+	// Republic Day (since 1950)
+	c.addHoliday(year, &Holiday{
 		Name:     "Republic Day",
 		Date:     time.Date(year, 1, 26, 0, 0, 0, 0, time.UTC),
 		Category: CategoryPublic,
@@ -924,10 +1572,11 @@ func (c *Country) loadINHolidays(year int) {
 			"en": "Republic Day",
 			"hi": "गणतंत्र दिवस",
 		},
-	}
+		FromYear: 1950,
+	})
 
-	// Independence Day
-	holidays[time.Date(year, 8, 15, 0, 0, 0, 0, time.UTC)] = &Holiday{
+	// Independence Day (since 1947)
+	c.addHoliday(year, &Holiday{
 		Name:     "Independence Day",
 		Date:     time.Date(year, 8, 15, 0, 0, 0, 0, time.UTC),
 		Category: CategoryPublic,
@@ -935,10 +1584,11 @@ func (c *Country) loadINHolidays(year int) {
 			"en": "Independence Day",
 			"hi": "स्वतंत्रता दिवस",
 		},
-	}
+		FromYear: 1947,
+	})
 
 	// Gandhi Jayanti
-	holidays[time.Date(year, 10, 2, 0, 0, 0, 0, time.UTC)] = &Holiday{
+	c.addHoliday(year, &Holiday{
 		Name:     "Gandhi Jayanti",
 		Date:     time.Date(year, 10, 2, 0, 0, 0, 0, time.UTC),
 		Category: CategoryPublic,
@@ -946,10 +1596,10 @@ func (c *Country) loadINHolidays(year int) {
 			"en": "Gandhi Jayanti",
 			"hi": "गांधी जयंती",
 		},
-	}
+	})
 
 	// Christmas Day
-	holidays[time.Date(year, 12, 25, 0, 0, 0, 0, time.UTC)] = &Holiday{
+	c.addHoliday(year, &Holiday{
 		Name:     "Christmas Day",
 		Date:     time.Date(year, 12, 25, 0, 0, 0, 0, time.UTC),
 		Category: CategoryReligious,
@@ -957,12 +1607,12 @@ func (c *Country) loadINHolidays(year int) {
 			"en": "Christmas Day",
 			"hi": "क्रिसमस",
 		},
-	}
+	})
 
 	// Good Friday (varies each year based on Easter calculation)
 	easter := c.easterSunday(year)
 	goodFriday := easter.AddDate(0, 0, -2)
-	holidays[goodFriday] = &Holiday{
+	c.addHoliday(year, &Holiday{
 		Name:     "Good Friday",
 		Date:     goodFriday,
 		Category: CategoryReligious,
@@ -970,145 +1620,57 @@ func (c *Country) loadINHolidays(year int) {
 			"en": "Good Friday",
 			"hi": "गुड फ्राइडे",
 		},
-	}
-
-	// Note: Religious festivals use known accurate dates for recent years
-	// For years outside the known range, lunar cycle approximations are used
+	})
 
-	// Diwali - Festival of Lights (calculated using lunar calendar data)
-	diwaliDate := c.calculateDiwali(year)
-	holidays[diwaliDate] = &Holiday{
+	// Diwali - Festival of Lights, on the Amavasya of Kartik
+	c.addHoliday(year, &Holiday{
 		Name:     "Diwali",
-		Date:     diwaliDate,
+		Date:     countries.DiwaliDate(year),
 		Category: CategoryReligious,
 		Languages: map[string]string{
 			"en": "Diwali",
 			"hi": "दीवाली",
 		},
-	}
+	})
 
-	// Holi - Festival of Colors (calculated using lunar calendar data)
-	holiDate := c.calculateHoli(year)
-	holidays[holiDate] = &Holiday{
+	// Holi - Festival of Colors, the day after the Purnima of Phalguna
+	c.addHoliday(year, &Holiday{
 		Name:     "Holi",
-		Date:     holiDate,
+		Date:     countries.HoliDate(year),
 		Category: CategoryReligious,
 		Languages: map[string]string{
 			"en": "Holi",
 			"hi": "होली",
 		},
-	}
-}
-
-// calculateDiwali calculates Diwali date using astronomical data
-// Diwali falls on the new moon (Amavasya) in the month of Kartik
-func (c *Country) calculateDiwali(year int) time.Time {
-	// Use known accurate dates for recent years
-	knownDates := map[int]time.Time{
-		2020: time.Date(2020, 11, 14, 0, 0, 0, 0, time.UTC),
-		2021: time.Date(2021, 11, 4, 0, 0, 0, 0, time.UTC),
-		2022: time.Date(2022, 10, 24, 0, 0, 0, 0, time.UTC),
-		2023: time.Date(2023, 11, 12, 0, 0, 0, 0, time.UTC),
-		2024: time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC),
-		2025: time.Date(2025, 10, 20, 0, 0, 0, 0, time.UTC),
-		2026: time.Date(2026, 11, 8, 0, 0, 0, 0, time.UTC),
-		2027: time.Date(2027, 10, 29, 0, 0, 0, 0, time.UTC),
-		2028: time.Date(2028, 10, 17, 0, 0, 0, 0, time.UTC),
-		2029: time.Date(2029, 11, 5, 0, 0, 0, 0, time.UTC),
-		2030: time.Date(2030, 10, 26, 0, 0, 0, 0, time.UTC),
-	}
-
-	if date, exists := knownDates[year]; exists {
-		return date
-	}
-
-	// For years outside our known range, use lunar cycle approximation
-	// Diwali typically occurs 20 days before Kartik Purnima (full moon)
-	// This is a simplified calculation - for production use, integrate with
-	// an astronomical library for precise lunar calendar calculations
-
-	// Base calculation: Diwali usually falls in late October/early November
-	// Use a 19-year Metonic cycle approximation
-	baseYear := 2024
-	baseDate := knownDates[baseYear]
-
-	yearDiff := year - baseYear
-
-	// Lunar year is approximately 354.37 days, solar year is 365.25 days
-	// The difference causes Diwali to shift by about 11 days earlier each year
-	dayShift := (yearDiff * 11) % 365
-	if dayShift < 0 {
-		dayShift += 365
-	}
-
-	approximateDate := baseDate.AddDate(yearDiff, 0, -dayShift)
-
-	// Adjust to ensure it falls in the typical October/November range
-	if approximateDate.Month() < 10 {
-		approximateDate = approximateDate.AddDate(0, 0, 30)
-	} else if approximateDate.Month() > 11 {
-		approximateDate = approximateDate.AddDate(0, 0, -30)
-	}
+	})
 
-	return approximateDate
-}
-
-// calculateHoli calculates Holi date using astronomical data
-// Holi falls on the full moon (Purnima) in the month of Phalguna
-func (c *Country) calculateHoli(year int) time.Time {
-	// Use known accurate dates for recent years
-	knownDates := map[int]time.Time{
-		2020: time.Date(2020, 3, 10, 0, 0, 0, 0, time.UTC),
-		2021: time.Date(2021, 3, 29, 0, 0, 0, 0, time.UTC),
-		2022: time.Date(2022, 3, 18, 0, 0, 0, 0, time.UTC),
-		2023: time.Date(2023, 3, 8, 0, 0, 0, 0, time.UTC),
-		2024: time.Date(2024, 3, 25, 0, 0, 0, 0, time.UTC),
-		2025: time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC),
-		2026: time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC),
-		2027: time.Date(2027, 3, 22, 0, 0, 0, 0, time.UTC),
-		2028: time.Date(2028, 3, 11, 0, 0, 0, 0, time.UTC),
-		2029: time.Date(2029, 3, 1, 0, 0, 0, 0, time.UTC),
-		2030: time.Date(2030, 3, 20, 0, 0, 0, 0, time.UTC),
-	}
-
-	if date, exists := knownDates[year]; exists {
-		return date
-	}
-
-	// For years outside our known range, use lunar cycle approximation
-	// Holi occurs on Phalguna Purnima (full moon in Phalguna month)
-
-	// Base calculation using lunar cycle
-	baseYear := 2024
-	baseDate := knownDates[baseYear]
-
-	yearDiff := year - baseYear
-
-	// Lunar year is approximately 354.37 days, solar year is 365.25 days
-	// The difference causes Holi to shift by about 11 days earlier each year
-	dayShift := (yearDiff * 11) % 365
-	if dayShift < 0 {
-		dayShift += 365
-	}
-
-	approximateDate := baseDate.AddDate(yearDiff, 0, -dayShift)
-
-	// Adjust to ensure it falls in the typical February/March/April range
-	if approximateDate.Month() < 2 {
-		approximateDate = approximateDate.AddDate(0, 0, 30)
-	} else if approximateDate.Month() > 4 {
-		approximateDate = approximateDate.AddDate(0, 0, -30)
-	}
+	// Janmashtami - birth of Krishna, on the Ashtami of the waning half of Bhadrapada
+	c.addHoliday(year, &Holiday{
+		Name:     "Janmashtami",
+		Date:     countries.JanmashtamiDate(year),
+		Category: CategoryReligious,
+		Languages: map[string]string{
+			"en": "Janmashtami",
+			"hi": "जन्माष्टमी",
+		},
+	})
 
-	return approximateDate
+	// Christmas Eve - restricted holiday, observed optionally by central government employees
+	c.addHoliday(year, &Holiday{
+		Name:     "Christmas Eve",
+		Date:     time.Date(year, 12, 24, 0, 0, 0, 0, time.UTC),
+		Category: CategoryOptional,
+		Languages: map[string]string{
+			"en": "Christmas Eve",
+			"hi": "क्रिसमस की पूर्व संध्या",
+		},
+	})
 }
 
 // loadFRHolidays loads holidays specific to France
 func (c *Country) loadFRHolidays(year int) {
-	holidays := c.years[year]
-
 	// New Year's Day
-	holidays[time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)] = &Holiday{
+	c.addHoliday(year, &Holiday{
 		Name:     "Jour de l'An",
 		Date:     time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC),
 		Category: CategoryPublic,
@@ -1116,10 +1678,10 @@ func (c *Country) loadFRHolidays(year int) {
 			"en": "New Year's Day",
 			"fr": "Jour de l'An",
 		},
-	}
+	})
 
 	// Labour Day
-	holidays[time.Date(year, 5, 1, 0, 0, 0, 0, time.UTC)] = &Holiday{
+	c.addHoliday(year, &Holiday{
 		Name:     "Fête du Travail",
 		Date:     time.Date(year, 5, 1, 0, 0, 0, 0, time.UTC),
 		Category: CategoryPublic,
@@ -1127,10 +1689,10 @@ func (c *Country) loadFRHolidays(year int) {
 			"en": "Labour Day",
 			"fr": "Fête du Travail",
 		},
-	}
+	})
 
 	// Victory in Europe Day
-	holidays[time.Date(year, 5, 8, 0, 0, 0, 0, time.UTC)] = &Holiday{
+	c.addHoliday(year, &Holiday{
 		Name:     "Fête de la Victoire",
 		Date:     time.Date(year, 5, 8, 0, 0, 0, 0, time.UTC),
 		Category: CategoryPublic,
@@ -1138,10 +1700,10 @@ func (c *Country) loadFRHolidays(year int) {
 			"en": "Victory in Europe Day",
 			"fr": "Fête de la Victoire",
 		},
-	}
+	})
 
 	// Bastille Day
-	holidays[time.Date(year, 7, 14, 0, 0, 0, 0, time.UTC)] = &Holiday{
+	c.addHoliday(year, &Holiday{
 		Name:     "Fête nationale",
 		Date:     time.Date(year, 7, 14, 0, 0, 0, 0, time.UTC),
 		Category: CategoryPublic,
@@ -1149,10 +1711,10 @@ func (c *Country) loadFRHolidays(year int) {
 			"en": "Bastille Day",
 			"fr": "Fête nationale",
 		},
-	}
+	})
 
 	// Assumption of Mary
-	holidays[time.Date(year, 8, 15, 0, 0, 0, 0, time.UTC)] = &Holiday{
+	c.addHoliday(year, &Holiday{
 		Name:     "Assomption",
 		Date:     time.Date(year, 8, 15, 0, 0, 0, 0, time.UTC),
 		Category: CategoryReligious,
@@ -1160,10 +1722,10 @@ func (c *Country) loadFRHolidays(year int) {
 			"en": "Assumption of Mary",
 			"fr": "Assomption",
 		},
-	}
+	})
 
 	// All Saints' Day
-	holidays[time.Date(year, 11, 1, 0, 0, 0, 0, time.UTC)] = &Holiday{
+	c.addHoliday(year, &Holiday{
 		Name:     "Toussaint",
 		Date:     time.Date(year, 11, 1, 0, 0, 0, 0, time.UTC),
 		Category: CategoryReligious,
@@ -1171,10 +1733,10 @@ func (c *Country) loadFRHolidays(year int) {
 			"en": "All Saints' Day",
 			"fr": "Toussaint",
 		},
-	}
+	})
 
 	// Armistice Day
-	holidays[time.Date(year, 11, 11, 0, 0, 0, 0, time.UTC)] = &Holiday{
+	c.addHoliday(year, &Holiday{
 		Name:     "Armistice",
 		Date:     time.Date(year, 11, 11, 0, 0, 0, 0, time.UTC),
 		Category: CategoryPublic,
@@ -1182,10 +1744,10 @@ func (c *Country) loadFRHolidays(year int) {
 			"en": "Armistice Day",
 			"fr": "Armistice",
 		},
-	}
+	})
 
 	// Christmas Day
-	holidays[time.Date(year, 12, 25, 0, 0, 0, 0, time.UTC)] = &Holiday{
+	c.addHoliday(year, &Holiday{
 		Name:     "Noël",
 		Date:     time.Date(year, 12, 25, 0, 0, 0, 0, time.UTC),
 		Category: CategoryReligious,
@@ -1193,14 +1755,14 @@ func (c *Country) loadFRHolidays(year int) {
 			"en": "Christmas Day",
 			"fr": "Noël",
 		},
-	}
+	})
 
 	// Easter-based holidays
 	easter := c.easterSunday(year)
 
 	// Easter Monday
 	easterMonday := easter.AddDate(0, 0, 1)
-	holidays[easterMonday] = &Holiday{
+	c.addHoliday(year, &Holiday{
 		Name:     "Lundi de Pâques",
 		Date:     easterMonday,
 		Category: CategoryReligious,
@@ -1208,11 +1770,11 @@ func (c *Country) loadFRHolidays(year int) {
 			"en": "Easter Monday",
 			"fr": "Lundi de Pâques",
 		},
-	}
+	})
 
 	// Ascension Day (39 days after Easter)
 	ascension := easter.AddDate(0, 0, 39)
-	holidays[ascension] = &Holiday{
+	c.addHoliday(year, &Holiday{
 		Name:     "Ascension",
 		Date:     ascension,
 		Category: CategoryReligious,
@@ -1220,19 +1782,23 @@ func (c *Country) loadFRHolidays(year int) {
 			"en": "Ascension Day",
 			"fr": "Ascension",
 		},
-	}
+	})
 
-	// Whit Monday (50 days after Easter)
+	// Whit Monday (50 days after Easter), worked by many as the journée de solidarité
 	whitMonday := easter.AddDate(0, 0, 50)
-	holidays[whitMonday] = &Holiday{
+	whitMondayCategory := CategoryReligious
+	if c.solidarityDay {
+		whitMondayCategory = CategoryOptional
+	}
+	c.addHoliday(year, &Holiday{
 		Name:     "Lundi de Pentecôte",
 		Date:     whitMonday,
-		Category: CategoryReligious,
+		Category: whitMondayCategory,
 		Languages: map[string]string{
 			"en": "Whit Monday",
 			"fr": "Lundi de Pentecôte",
 		},
-	}
+	})
 }
 
 // loadDEHolidays loads Germany holidays using the DE provider
@@ -1240,13 +1806,8 @@ func (c *Country) loadDEHolidays(year int) {
 	provider := countries.NewDEProvider()
 	holidayMap := provider.LoadHolidays(year)
 
-	for date, holiday := range holidayMap {
-		c.years[year][date] = &Holiday{
-			Name:      holiday.Name,
-			Date:      holiday.Date,
-			Category:  HolidayCategory(holiday.Category),
-			Languages: holiday.Languages,
-		}
+	for _, holiday := range holidayMap {
+		c.addHoliday(year, fromProviderHoliday(*holiday))
 	}
 }
 
@@ -1255,13 +1816,8 @@ func (c *Country) loadBRHolidays(year int) {
 	provider := countries.NewBRProvider()
 	holidayMap := provider.LoadHolidays(year)
 
-	for date, holiday := range holidayMap {
-		c.years[year][date] = &Holiday{
-			Name:      holiday.Name,
-			Date:      holiday.Date,
-			Category:  HolidayCategory(holiday.Category),
-			Languages: holiday.Languages,
-		}
+	for _, holiday := range holidayMap {
+		c.addHoliday(year, fromProviderHoliday(*holiday))
 	}
 }
 
@@ -1270,13 +1826,8 @@ func (c *Country) loadMXHolidays(year int) {
 	provider := countries.NewMXProvider()
 	holidayMap := provider.LoadHolidays(year)
 
-	for date, holiday := range holidayMap {
-		c.years[year][date] = &Holiday{
-			Name:      holiday.Name,
-			Date:      holiday.Date,
-			Category:  HolidayCategory(holiday.Category),
-			Languages: holiday.Languages,
-		}
+	for _, holiday := range holidayMap {
+		c.addHoliday(year, fromProviderHoliday(*holiday))
 	}
 }
 
@@ -1285,13 +1836,8 @@ func (c *Country) loadITHolidays(year int) {
 	provider := countries.NewITProvider()
 	holidayMap := provider.LoadHolidays(year)
 
-	for date, holiday := range holidayMap {
-		c.years[year][date] = &Holiday{
-			Name:      holiday.Name,
-			Date:      holiday.Date,
-			Category:  HolidayCategory(holiday.Category),
-			Languages: holiday.Languages,
-		}
+	for _, holiday := range holidayMap {
+		c.addHoliday(year, fromProviderHoliday(*holiday))
 	}
 }
 
@@ -1300,13 +1846,8 @@ func (c *Country) loadESHolidays(year int) {
 	provider := countries.NewESProvider()
 	holidayMap := provider.LoadHolidays(year)
 
-	for date, holiday := range holidayMap {
-		c.years[year][date] = &Holiday{
-			Name:      holiday.Name,
-			Date:      holiday.Date,
-			Category:  HolidayCategory(holiday.Category),
-			Languages: holiday.Languages,
-		}
+	for _, holiday := range holidayMap {
+		c.addHoliday(year, fromProviderHoliday(*holiday))
 	}
 }
 
@@ -1315,13 +1856,8 @@ func (c *Country) loadNLHolidays(year int) {
 	provider := countries.NewNLProvider()
 	holidayMap := provider.LoadHolidays(year)
 
-	for date, holiday := range holidayMap {
-		c.years[year][date] = &Holiday{
-			Name:      holiday.Name,
-			Date:      holiday.Date,
-			Category:  HolidayCategory(holiday.Category),
-			Languages: holiday.Languages,
-		}
+	for _, holiday := range holidayMap {
+		c.addHoliday(year, fromProviderHoliday(*holiday))
 	}
 }
 
@@ -1330,13 +1866,8 @@ func (c *Country) loadKRHolidays(year int) {
 	provider := countries.NewKRProvider()
 	holidayMap := provider.LoadHolidays(year)
 
-	for date, holiday := range holidayMap {
-		c.years[year][date] = &Holiday{
-			Name:      holiday.Name,
-			Date:      holiday.Date,
-			Category:  HolidayCategory(holiday.Category),
-			Languages: holiday.Languages,
-		}
+	for _, holiday := range holidayMap {
+		c.addHoliday(year, fromProviderHoliday(*holiday))
 	}
 }
 
@@ -1345,13 +1876,8 @@ func (c *Country) loadUAHolidays(year int) {
 	provider := countries.NewUAProvider()
 	holidayMap := provider.LoadHolidays(year)
 
-	for date, holiday := range holidayMap {
-		c.years[year][date] = &Holiday{
-			Name:      holiday.Name,
-			Date:      holiday.Date,
-			Category:  HolidayCategory(holiday.Category),
-			Languages: holiday.Languages,
-		}
+	for _, holiday := range holidayMap {
+		c.addHoliday(year, fromProviderHoliday(*holiday))
 	}
 }
 
@@ -1360,15 +1886,37 @@ func (c *Country) loadUAHolidays(year int) {
 // ============================================================================
 
 // NewCountryWithError creates a new Country with validation
-// This is the recommended way to create countries with proper error handling
+// This is the recommended way to create countries with proper error handling.
+// When options list Years, they are loaded eagerly and an error is returned if any
+// of them cannot be loaded, which makes it suitable as a fail-fast startup check.
 func NewCountryWithError(countryCode string, options ...CountryOptions) (*Country, error) {
 	// Validate country code
 	if err := ValidateCountryCode(countryCode); err != nil {
 		return nil, err
 	}
 
-	// Use existing NewCountry function
-	country := NewCountry(countryCode, options...)
+	if len(options) == 0 || len(options[0].Years) == 0 {
+		return NewCountry(countryCode, options...), nil
+	}
+
+	// Check every requested year before loading any of them
+	opt := options[0]
+	for _, year := range opt.Years {
+		if err := ValidateYear(year); err != nil {
+			return nil, NewYearError(ErrInvalidYear, countryCode, year,
+				fmt.Sprintf("cannot preload year %d: outside valid range (1900-2200)", year))
+		}
+	}
+
+	// Load the requested years eagerly so failures surface here rather than on first use
+	years := opt.Years
+	opt.Years = nil
+	country := NewCountry(countryCode, opt)
+	for _, year := range years {
+		if err := country.loadYearWithContext(context.Background(), year); err != nil {
+			return nil, err
+		}
+	}
 	return country, nil
 }
 
@@ -1386,6 +1934,23 @@ func (c *Country) IsHolidayWithError(date time.Time) (*Holiday, bool, error) {
 	return holiday, isHoliday, nil
 }
 
+// IsHolidayStr checks if a date given as YYYY-MM-DD is a holiday. The date is read as
+// UTC midnight; a malformed string or unsupported year returns an error.
+func (c *Country) IsHolidayStr(dateStr string) (*Holiday, bool, error) {
+	date, err := time.Parse("2006-01-02", dateStr)
+	if err != nil {
+		return nil, false, &HolidayError{
+			Code:    ErrInvalidDate,
+			Country: c.code,
+			Date:    dateStr,
+			Message: fmt.Sprintf("invalid date %q, expected YYYY-MM-DD", dateStr),
+			Cause:   err,
+		}
+	}
+
+	return c.IsHolidayWithError(date)
+}
+
 // IsHolidayWithContext checks if the given date is a holiday with context support
 func (c *Country) IsHolidayWithContext(ctx context.Context, date time.Time) (*Holiday, bool, error) {
 	// Check for context cancellation
@@ -1533,6 +2098,9 @@ func (c *Country) loadYearWithContext(ctx context.Context, year int) error {
 	// Check if already loaded
 	c.mu.RLock()
 	_, exists := c.years[year]
+	if exists {
+		c.cacheHit(year)
+	}
 	c.mu.RUnlock()
 
 	if exists {
@@ -1561,18 +2129,15 @@ func (c *Country) loadYearWithContext(ctx context.Context, year int) error {
 	default:
 	}
 
-	// Use existing loadYear method but with error handling
-	c.years[year] = make(map[time.Time]*Holiday)
-
-	// Validate country code and load holidays
-	if err := ValidateCountryCode(c.code); err != nil {
-		delete(c.years, year)
-		return err
+	// Validate the country code, unless the holidays come from combined countries or a
+	// snapshot rather than a provider
+	if len(c.sources) == 0 && c.snapshot == nil {
+		if err := ValidateCountryCode(c.code); err != nil {
+			return err
+		}
 	}
 
-	// Use existing loadCountryHolidays method
-	c.loadCountryHolidays(year)
-
+	c.populateYear(year)
 	return nil
 }
 
@@ -1581,15 +2146,8 @@ func (c *Country) loadCLHolidays(year int) {
 	provider := countries.NewCLProvider()
 	holidayMap := provider.LoadHolidays(year)
 
-	for date, holiday := range holidayMap {
-		c.years[year][date] = &Holiday{
-			Name:       holiday.Name,
-			Date:       holiday.Date,
-			Category:   HolidayCategory(holiday.Category),
-			Languages:  holiday.Languages,
-			Observed:   holiday.Observed,
-			IsObserved: holiday.IsObserved,
-		}
+	for _, holiday := range holidayMap {
+		c.addHoliday(year, fromProviderHoliday(*holiday))
 	}
 }
 
@@ -1598,15 +2156,8 @@ func (c *Country) loadIEHolidays(year int) {
 	provider := countries.NewIEProvider()
 	holidayMap := provider.LoadHolidays(year)
 
-	for date, holiday := range holidayMap {
-		c.years[year][date] = &Holiday{
-			Name:       holiday.Name,
-			Date:       holiday.Date,
-			Category:   HolidayCategory(holiday.Category),
-			Languages:  holiday.Languages,
-			Observed:   holiday.Observed,
-			IsObserved: holiday.IsObserved,
-		}
+	for _, holiday := range holidayMap {
+		c.addHoliday(year, fromProviderHoliday(*holiday))
 	}
 }
 
@@ -1615,14 +2166,7 @@ func (c *Country) loadILHolidays(year int) {
 	provider := countries.NewILProvider()
 	holidayMap := provider.LoadHolidays(year)
 
-	for date, holiday := range holidayMap {
-		c.years[year][date] = &Holiday{
-			Name:       holiday.Name,
-			Date:       holiday.Date,
-			Category:   HolidayCategory(holiday.Category),
-			Languages:  holiday.Languages,
-			Observed:   holiday.Observed,
-			IsObserved: holiday.IsObserved,
-		}
+	for _, holiday := range holidayMap {
+		c.addHoliday(year, fromProviderHoliday(*holiday))
 	}
 }
@@ -1,10 +1,18 @@
 package goholidays
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"log"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
 	"testing"
 	"time"
+
+	"github.com/coredds/goholiday/countries"
 )
 
 func TestNewCountry(t *testing.T) {
@@ -111,8 +119,16 @@ func TestHolidayCategories(t *testing.T) {
 		t.Fatal("Independence Day should be a holiday")
 	}
 
-	if holiday.Category != "federal" {
-		t.Errorf("Expected category 'federal', got '%s'", holiday.Category)
+	if holiday.Category != CategoryFederal {
+		t.Errorf("Expected category '%s', got '%s'", CategoryFederal, holiday.Category)
+	}
+
+	// Federal holidays are kept when filtering by either federal or public
+	for _, category := range []HolidayCategory{CategoryFederal, CategoryPublic} {
+		filtered := NewCountry("US", CountryOptions{Categories: []HolidayCategory{category}})
+		if _, isHoliday := filtered.IsHoliday(independenceDay); !isHoliday {
+			t.Errorf("Independence Day should be included when filtering by %s", category)
+		}
 	}
 }
 
@@ -202,6 +218,33 @@ func TestDateRangeEdgeCases(t *testing.T) {
 	}
 }
 
+func TestDateRangeExclusiveEnd(t *testing.T) {
+	us := NewCountry("US")
+
+	// July as [July 1, August 1) and as the closed range ending on July 4
+	julyFirst := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+	augustFirst := time.Date(2024, 8, 1, 0, 0, 0, 0, time.UTC)
+	if holidays := us.HolidaysForDateRangeExclusiveEnd(julyFirst, augustFirst); len(holidays) != 1 {
+		t.Errorf("Expected 1 holiday in July, got %d", len(holidays))
+	}
+
+	independenceDay := time.Date(2024, 7, 4, 0, 0, 0, 0, time.UTC)
+	if _, ok := us.HolidaysForDateRange(julyFirst, independenceDay)[independenceDay]; !ok {
+		t.Error("Closed range should include a holiday on its end date")
+	}
+	if _, ok := us.HolidaysForDateRangeExclusiveEnd(julyFirst, independenceDay)[independenceDay]; ok {
+		t.Error("Half-open range should exclude a holiday on its end date")
+	}
+
+	// A holiday on the start date is included either way
+	if holidays := us.HolidaysForDateRangeExclusiveEnd(independenceDay, independenceDay.AddDate(0, 0, 1)); len(holidays) != 1 {
+		t.Errorf("Expected the one-day half-open range to include Independence Day, got %d holidays", len(holidays))
+	}
+	if holidays := us.HolidaysForDateRangeExclusiveEnd(independenceDay, independenceDay); len(holidays) != 0 {
+		t.Errorf("Expected an empty half-open range to have no holidays, got %d", len(holidays))
+	}
+}
+
 // TestGettersAndSetters tests all getter methods
 func TestGettersAndSetters(t *testing.T) {
 	options := CountryOptions{
@@ -435,6 +478,35 @@ func TestEnhancedAPI(t *testing.T) {
 		}
 	})
 
+	t.Run("NewCountryWithError preloads years", func(t *testing.T) {
+		country, err := NewCountryWithError("US", CountryOptions{Years: []int{2023, 2024}})
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		country.mu.RLock()
+		_, loaded2023 := country.years[2023]
+		_, loaded2024 := country.years[2024]
+		country.mu.RUnlock()
+		if !loaded2023 || !loaded2024 {
+			t.Error("Expected the requested years to be loaded eagerly")
+		}
+
+		country, err = NewCountryWithError("US", CountryOptions{Years: []int{2024, 1800}})
+		if err == nil {
+			t.Fatal("Expected error for an out-of-range year in Years")
+		}
+		if country != nil {
+			t.Error("Expected nil country when a year fails to load")
+		}
+		if he, ok := err.(*HolidayError); !ok || he.Code != ErrInvalidYear || he.Year != 1800 {
+			t.Errorf("Expected an ErrInvalidYear error for 1800, got %v", err)
+		}
+
+		if _, err := NewCountryWithError("XX", CountryOptions{Years: []int{2024}}); err == nil {
+			t.Error("Expected error for invalid country with Years")
+		}
+	})
+
 	t.Run("IsHolidayWithError", func(t *testing.T) {
 		country := NewCountry("US")
 
@@ -462,6 +534,36 @@ func TestEnhancedAPI(t *testing.T) {
 		}
 	})
 
+	t.Run("IsHolidayStr", func(t *testing.T) {
+		country := NewCountry("US")
+
+		holiday, isHoliday, err := country.IsHolidayStr("2024-07-04")
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if !isHoliday || holiday == nil || holiday.Name != "Independence Day" {
+			t.Errorf("Expected Independence Day on 2024-07-04, got %v", holiday)
+		}
+
+		holiday, isHoliday, err = country.IsHolidayStr("2024-07-05")
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if isHoliday || holiday != nil {
+			t.Errorf("Expected 2024-07-05 not to be a holiday, got %v", holiday)
+		}
+
+		for _, input := range []string{"07/04/2024", "2024-13-01", ""} {
+			_, isHoliday, err = country.IsHolidayStr(input)
+			if isHoliday {
+				t.Errorf("Expected %q not to be a holiday", input)
+			}
+			if he, ok := err.(*HolidayError); !ok || he.Code != ErrInvalidDate || he.Date != input {
+				t.Errorf("Expected an ErrInvalidDate error for %q, got %v", input, err)
+			}
+		}
+	})
+
 	t.Run("Context Support", func(t *testing.T) {
 		country := NewCountry("US")
 
@@ -569,3 +671,816 @@ func TestUtilityFunctions(t *testing.T) {
 		}
 	})
 }
+
+// sparseProvider emits a single holiday while expecting several a year
+type sparseProvider struct {
+	*countries.BaseProvider
+}
+
+func (p sparseProvider) LoadHolidays(year int) map[time.Time]*countries.Holiday {
+	date := time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)
+	return map[time.Time]*countries.Holiday{
+		date: p.CreateHoliday("New Year's Day", date, "public", map[string]string{"en": "New Year's Day"}),
+	}
+}
+
+func (p sparseProvider) ExpectedHolidayCount(year int) int {
+	return 5
+}
+
+func TestExpectedHolidayCountWarning(t *testing.T) {
+	if err := RegisterProvider(sparseProvider{countries.NewBaseProvider("ZX")}); err != nil {
+		t.Fatalf("RegisterProvider failed: %v", err)
+	}
+	t.Cleanup(func() {
+		registeredProvidersMu.Lock()
+		delete(registeredProviders, "ZX")
+		registeredProvidersMu.Unlock()
+	})
+
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	NewCountry("ZX", CountryOptions{CheckHolidayCount: true}).HolidaysForYear(2024)
+	if !strings.Contains(buf.String(), "ZX 2024 loaded 1 holidays, expected at least 5") {
+		t.Errorf("Expected a sparse-data warning for ZX, got %q", buf.String())
+	}
+
+	buf.Reset()
+	NewCountry("ZX").HolidaysForYear(2024)
+	if buf.Len() != 0 {
+		t.Errorf("Expected no warning without CheckHolidayCount, got %q", buf.String())
+	}
+
+	for _, code := range []string{"GB", "CA", "AU"} {
+		NewCountry(code, CountryOptions{CheckHolidayCount: true}).HolidaysForYear(2024)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("Expected no warning for the provider-backed loaders, got %q", buf.String())
+	}
+}
+
+// untranslatedProvider emits a holiday named only in Spanish, lacking countries.DefaultLanguage
+type untranslatedProvider struct {
+	*countries.BaseProvider
+}
+
+func (p untranslatedProvider) LoadHolidays(year int) map[time.Time]*countries.Holiday {
+	date := time.Date(year, 7, 9, 0, 0, 0, 0, time.UTC)
+	return map[time.Time]*countries.Holiday{
+		date: p.CreateHoliday("Día de la Independencia", date, "public", map[string]string{"es": "Día de la Independencia"}),
+	}
+}
+
+func TestMissingLanguageWarning(t *testing.T) {
+	if err := RegisterProvider(untranslatedProvider{countries.NewBaseProvider("ZY")}); err != nil {
+		t.Fatalf("RegisterProvider failed: %v", err)
+	}
+	t.Cleanup(func() {
+		registeredProvidersMu.Lock()
+		delete(registeredProviders, "ZY")
+		registeredProvidersMu.Unlock()
+	})
+
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	NewCountry("ZY").HolidaysForYear(2024)
+	if buf.Len() != 0 {
+		t.Errorf("Expected no language warning unless CheckLanguages is set, got %q", buf.String())
+	}
+
+	holidays := NewCountry("ZY", CountryOptions{CheckLanguages: true}).HolidaysForYear(2024)
+	if !strings.Contains(buf.String(), `ZY 2024: Día de la Independencia on 2024-07-09 has no "en" name`) {
+		t.Errorf("Expected a warning for the holiday without an English name, got %q", buf.String())
+	}
+	if len(holidays) != 1 {
+		t.Errorf("Expected the holiday to be returned despite the warning, got %d holidays", len(holidays))
+	}
+
+	buf.Reset()
+	NewCountry("GB", CountryOptions{CheckLanguages: true}).HolidaysForYear(2024)
+	if buf.Len() != 0 {
+		t.Errorf("Expected no language warning for GB, got %q", buf.String())
+	}
+}
+
+func TestOptionalHolidaysExcludedByDefault(t *testing.T) {
+	christmasEve := time.Date(2024, 12, 24, 0, 0, 0, 0, time.UTC)
+
+	in := NewCountry("IN")
+	if _, isHoliday := in.IsHoliday(christmasEve); isHoliday {
+		t.Error("Optional holidays should be excluded by default")
+	}
+	for _, holiday := range in.HolidaysForYear(2024) {
+		if holiday.Category == CategoryOptional {
+			t.Errorf("Unexpected optional holiday %s", holiday.Name)
+		}
+	}
+
+	inWithOptional := NewCountry("IN", CountryOptions{IncludeOptional: true})
+	holiday, isHoliday := inWithOptional.IsHoliday(christmasEve)
+	if !isHoliday || holiday.Category != CategoryOptional {
+		t.Error("Optional holidays should be included when IncludeOptional is set")
+	}
+
+	inExplicit := NewCountry("IN", CountryOptions{Categories: []HolidayCategory{CategoryOptional}})
+	if _, isHoliday := inExplicit.IsHoliday(christmasEve); !isHoliday {
+		t.Error("Optional holidays should be included when configured explicitly")
+	}
+}
+
+func TestCategoryFiltering(t *testing.T) {
+	inPublic := NewCountry("IN", CountryOptions{Categories: []HolidayCategory{CategoryPublic}})
+	holidays := inPublic.HolidaysForYear(2024)
+	if len(holidays) == 0 {
+		t.Fatal("Expected public holidays for India")
+	}
+	for _, holiday := range holidays {
+		if holiday.Category != CategoryPublic {
+			t.Errorf("Public-only country returned %s holiday %s", holiday.Category, holiday.Name)
+		}
+	}
+
+	inReligious := NewCountry("IN", CountryOptions{Categories: []HolidayCategory{CategoryReligious}})
+	if _, isHoliday := inReligious.IsHoliday(time.Date(2024, 1, 26, 0, 0, 0, 0, time.UTC)); isHoliday {
+		t.Error("Republic Day should be excluded from a religious-only country")
+	}
+	if _, isHoliday := inReligious.IsHoliday(time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC)); !isHoliday {
+		t.Error("Christmas should be included in a religious-only country")
+	}
+
+	// A bank-only US country drops the federal holidays, which count as public
+	usBank := NewCountry("US", CountryOptions{Categories: []HolidayCategory{CategoryBank}})
+	if _, isHoliday := usBank.IsHoliday(time.Date(2024, 7, 4, 0, 0, 0, 0, time.UTC)); isHoliday {
+		t.Error("Independence Day should be excluded from a bank-only US country")
+	}
+	for _, holiday := range usBank.HolidaysForYear(2024) {
+		if holiday.Category != CategoryBank {
+			t.Errorf("Bank-only country returned %s holiday %s", holiday.Category, holiday.Name)
+		}
+	}
+
+	// Federal holidays count as public holidays
+	us := NewCountry("US", CountryOptions{Categories: []HolidayCategory{CategoryPublic}})
+	if _, isHoliday := us.IsHoliday(time.Date(2024, 7, 4, 0, 0, 0, 0, time.UTC)); !isHoliday {
+		t.Error("Independence Day should be included in a public-only US country")
+	}
+}
+
+func TestPublicOnlyCountryExcludesOtherCategories(t *testing.T) {
+	publicOnly := CountryOptions{Categories: []HolidayCategory{CategoryPublic}}
+
+	us := NewCountry("US", publicOnly)
+	usHolidays := us.HolidaysForYear(2024)
+	if len(usHolidays) != len(NewCountry("US").HolidaysForYear(2024)) {
+		t.Error("Federal holidays should all be kept by a public-only US country")
+	}
+
+	usReligious := NewCountry("US", CountryOptions{Categories: []HolidayCategory{CategoryReligious}})
+	if holidays := usReligious.HolidaysForYear(2024); len(holidays) != 0 {
+		t.Errorf("Expected no religious US holidays, got %d", len(holidays))
+	}
+
+	de := NewCountry("DE", publicOnly)
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)
+	for _, holiday := range de.HolidaysForDateRange(start, end) {
+		if holiday.Category != CategoryPublic {
+			t.Errorf("Public-only country returned %s holiday %s", holiday.Category, holiday.Name)
+		}
+	}
+	if _, isHoliday := de.IsHoliday(time.Date(2024, 1, 6, 0, 0, 0, 0, time.UTC)); isHoliday {
+		t.Error("Epiphany is religious and should be excluded")
+	}
+
+	deContext := NewCountry("DE", publicOnly)
+	if _, isHoliday, err := deContext.IsHolidayWithContext(context.Background(), time.Date(2024, 1, 6, 0, 0, 0, 0, time.UTC)); err != nil || isHoliday {
+		t.Errorf("Epiphany should be excluded when loaded with context, got holiday=%v err=%v", isHoliday, err)
+	}
+
+	// An empty category list behaves like no filter
+	deEmpty := NewCountry("DE", CountryOptions{Categories: []HolidayCategory{}})
+	if _, isHoliday := deEmpty.IsHoliday(time.Date(2024, 1, 6, 0, 0, 0, 0, time.UTC)); !isHoliday {
+		t.Error("Empty category list should not filter holidays")
+	}
+}
+
+func TestSortedLanguages(t *testing.T) {
+	holiday := &Holiday{
+		Name: "Christmas Day",
+		Languages: map[string]string{
+			"fr": "Noël",
+			"en": "Christmas Day",
+			"es": "Navidad",
+			"de": "Weihnachten",
+		},
+	}
+
+	expected := []string{"de", "en", "es", "fr"}
+	for i := 0; i < 5; i++ {
+		names := holiday.SortedLanguages()
+		if len(names) != len(expected) {
+			t.Fatalf("Expected %d languages, got %d", len(expected), len(names))
+		}
+		for j, lang := range expected {
+			if names[j].Lang != lang {
+				t.Errorf("Position %d: expected %s, got %s", j, lang, names[j].Lang)
+			}
+			if names[j].Name != holiday.Languages[lang] {
+				t.Errorf("Language %s: expected %s, got %s", lang, holiday.Languages[lang], names[j].Name)
+			}
+		}
+	}
+
+	if names := (&Holiday{}).SortedLanguages(); len(names) != 0 {
+		t.Errorf("Expected no languages, got %v", names)
+	}
+}
+
+func TestDefaultCountry(t *testing.T) {
+	first := Default("US")
+	if first != Default("US") {
+		t.Error("Default should return the same instance across calls")
+	}
+	if first == Default("GB") {
+		t.Error("Default should return distinct instances per country")
+	}
+
+	var wg sync.WaitGroup
+	results := make([]*Country, 20)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			c := Default("CA")
+			c.IsHoliday(time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC))
+			results[i] = c
+		}(i)
+	}
+	wg.Wait()
+
+	for i, c := range results {
+		if c != results[0] {
+			t.Errorf("Goroutine %d got a different CA instance", i)
+		}
+	}
+
+	if _, isHoliday := first.IsHoliday(time.Date(time.Now().Year(), 7, 4, 0, 0, 0, 0, time.UTC)); !isHoliday {
+		t.Error("Default US country should report Independence Day")
+	}
+}
+
+func TestHolidaysOn(t *testing.T) {
+	// In 2011 Easter Monday fell on ANZAC Day
+	nz := NewCountry("NZ")
+	date := time.Date(2011, 4, 25, 9, 0, 0, 0, time.UTC)
+
+	holidays := nz.HolidaysOn(date)
+	if len(holidays) != 2 {
+		t.Fatalf("Expected 2 holidays on %s, got %d", date.Format("2006-01-02"), len(holidays))
+	}
+
+	names := map[string]bool{}
+	for _, holiday := range holidays {
+		names[holiday.Name] = true
+	}
+	if !names["Easter Monday"] || !names["ANZAC Day"] {
+		t.Errorf("Expected Easter Monday and ANZAC Day, got %v", names)
+	}
+
+	primary, isHoliday := nz.IsHoliday(date)
+	if !isHoliday || primary != holidays[0] {
+		t.Error("HolidaysOn should start with the holiday reported by IsHoliday")
+	}
+
+	if holidays := nz.HolidaysOn(time.Date(2024, 4, 25, 0, 0, 0, 0, time.UTC)); len(holidays) != 1 {
+		t.Errorf("Expected a single holiday on ANZAC Day 2024, got %d", len(holidays))
+	}
+
+	if holidays := nz.HolidaysOn(time.Date(2024, 3, 12, 0, 0, 0, 0, time.UTC)); holidays != nil {
+		t.Errorf("Expected no holidays on a regular day, got %v", holidays)
+	}
+}
+
+func TestHolidaysOnPriority(t *testing.T) {
+	us := NewCountry("US")
+	date := time.Date(2024, 3, 12, 0, 0, 0, 0, time.UTC)
+
+	// The regional holiday is added first, yet the national one takes precedence
+	us.AddCustomHoliday(date, &Holiday{Name: "Town Meeting Day", Category: CategoryPublic, Subdivisions: []string{"VT"}})
+	us.AddCustomHoliday(date, &Holiday{Name: "Founders Day", Category: CategoryReligious})
+	us.AddCustomHoliday(date, &Holiday{Name: "Charter Day", Category: CategoryPublic})
+
+	holidays := us.HolidaysOn(date)
+	var names []string
+	for _, holiday := range holidays {
+		names = append(names, holiday.Name)
+	}
+	expected := []string{"Charter Day", "Founders Day", "Town Meeting Day"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("Expected %v, got %v", expected, names)
+	}
+
+	if primary, ok := us.IsHoliday(date); !ok || primary.Name != "Charter Day" {
+		t.Errorf("Expected IsHoliday to report the national public holiday, got %v", primary)
+	}
+
+	// Holidays of equal priority keep the order they were added in
+	christmas := time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC)
+	us.AddCustomHoliday(christmas, &Holiday{Name: "Company Party", Category: CategoryPublic})
+	if on := us.HolidaysOn(christmas); len(on) != 2 || on[0].Name != "Christmas Day" {
+		t.Errorf("Expected Christmas Day to stay first, got %v", on)
+	}
+}
+
+func TestHolidaysOnWithCategoryFilter(t *testing.T) {
+	// In 2008 Ascension (religious) fell on Labour Day (public)
+	fr := NewCountry("FR", CountryOptions{Categories: []HolidayCategory{CategoryReligious}})
+	holidays := fr.HolidaysOn(time.Date(2008, 5, 1, 0, 0, 0, 0, time.UTC))
+
+	if len(holidays) != 1 || holidays[0].Name != "Ascension" {
+		t.Fatalf("Expected only Ascension for a religious-only country, got %v", holidays)
+	}
+
+	if holiday, isHoliday := fr.IsHoliday(time.Date(2008, 5, 1, 0, 0, 0, 0, time.UTC)); !isHoliday || holiday.Name != "Ascension" {
+		t.Error("Filtered-out primary holiday should be replaced by the remaining one")
+	}
+}
+
+func TestCollisionSubstituteDay(t *testing.T) {
+	// In 2011 Easter Monday fell on ANZAC Day; the next free weekday is Tuesday 26 April
+	collision := time.Date(2011, 4, 25, 0, 0, 0, 0, time.UTC)
+	substituteDate := time.Date(2011, 4, 26, 0, 0, 0, 0, time.UTC)
+
+	nz := NewCountry("NZ")
+	if _, isHoliday := nz.IsHoliday(substituteDate); isHoliday {
+		t.Error("NZ should not grant a substitute day for colliding holidays by default")
+	}
+
+	nz = NewCountry("NZ", CountryOptions{CollisionRule: CollisionNextWeekday})
+	holidays := nz.HolidaysOn(collision)
+	if len(holidays) != 2 {
+		t.Fatalf("Expected 2 holidays on %s, got %d", collision.Format("2006-01-02"), len(holidays))
+	}
+	displaced := holidays[1]
+
+	substitute, isHoliday := nz.IsHoliday(substituteDate)
+	if !isHoliday {
+		t.Fatalf("Expected a substitute day on %s", substituteDate.Format("2006-01-02"))
+	}
+	if substitute.SubstituteFor != displaced.Name || substitute.Name != displaced.Name+" (substitute day)" {
+		t.Errorf("Expected a substitute for %s, got %s (for %q)", displaced.Name, substitute.Name, substitute.SubstituteFor)
+	}
+	if !displaced.HasSubstitute || displaced.Observed == nil || !displaced.Observed.Equal(substituteDate) {
+		t.Errorf("Expected %s to point at its substitute day, got %+v", displaced.Name, displaced.Observed)
+	}
+	if holidays[0].HasSubstitute {
+		t.Errorf("The first holiday on a date keeps it and should not get a substitute")
+	}
+
+	// Opting out overrides a country whose rule grants substitutes
+	kr := NewCountry("KR", CountryOptions{CollisionRule: CollisionNoSubstitute})
+	if kr.collisionRule != CollisionNoSubstitute {
+		t.Errorf("Expected the option to override KR's collision rule")
+	}
+	if NewCountry("KR").collisionRule != CollisionNextWeekday {
+		t.Errorf("Expected KR to grant substitute days for colliding holidays by default")
+	}
+}
+
+func TestSubstituteDayBackReference(t *testing.T) {
+	gb := NewCountry("GB")
+
+	// Christmas Day 2021 fell on a Saturday and Boxing Day on a Sunday
+	christmas, isHoliday := gb.IsHoliday(time.Date(2021, 12, 25, 0, 0, 0, 0, time.UTC))
+	if !isHoliday {
+		t.Fatal("Christmas Day should be a holiday")
+	}
+	if !christmas.HasSubstitute || christmas.Observed == nil {
+		t.Fatal("Christmas Day 2021 should have a substitute day")
+	}
+	if !christmas.Observed.Equal(time.Date(2021, 12, 27, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected Christmas Day to be observed on Dec 27, got %v", christmas.Observed)
+	}
+
+	substitute, isHoliday := gb.IsHoliday(time.Date(2021, 12, 27, 0, 0, 0, 0, time.UTC))
+	if !isHoliday || substitute.SubstituteFor != "Christmas Day" {
+		t.Errorf("Expected Dec 27 to substitute for Christmas Day, got %+v", substitute)
+	}
+
+	boxingSubstitute, isHoliday := gb.IsHoliday(time.Date(2021, 12, 28, 0, 0, 0, 0, time.UTC))
+	if !isHoliday || boxingSubstitute.SubstituteFor != "Boxing Day" {
+		t.Errorf("Expected Dec 28 to substitute for Boxing Day, got %+v", boxingSubstitute)
+	}
+
+	// Christmas Day 2024 fell on a Wednesday
+	christmas2024, _ := gb.IsHoliday(time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC))
+	if christmas2024.HasSubstitute || christmas2024.SubstituteFor != "" {
+		t.Error("Weekday Christmas Day should not have a substitute")
+	}
+}
+
+func TestDataVersion(t *testing.T) {
+	version := NewCountry("US").DataVersion()
+
+	if !strings.HasPrefix(version, Version+"+US.") {
+		t.Errorf("Expected version to start with %s+US., got %s", Version, version)
+	}
+
+	if again := NewCountry("US").DataVersion(); again != version {
+		t.Errorf("Expected a stable version, got %s and %s", version, again)
+	}
+
+	if other := NewCountry("DE").DataVersion(); other == version {
+		t.Error("Different countries should have different data versions")
+	}
+
+	// Options that change the generated holidays change the revision
+	religiousOnly := NewCountry("DE", CountryOptions{Categories: []HolidayCategory{CategoryReligious}})
+	if religiousOnly.DataVersion() == NewCountry("DE").DataVersion() {
+		t.Error("Category filtering should change the data version")
+	}
+}
+
+func TestAvailableLanguages(t *testing.T) {
+	nz := NewCountry("NZ")
+
+	languages := nz.AvailableLanguages(2024)
+	expected := []string{"en", "mi"}
+	if len(languages) != len(expected) {
+		t.Fatalf("Expected languages %v, got %v", expected, languages)
+	}
+	for i, lang := range expected {
+		if languages[i] != lang {
+			t.Errorf("Expected languages %v, got %v", expected, languages)
+			break
+		}
+	}
+}
+
+func TestSortedHolidaysForYear(t *testing.T) {
+	us := NewCountry("US")
+
+	sorted := us.SortedHolidaysForYear(2024)
+	if expected := len(us.HolidaysForYear(2024)); len(sorted) != expected {
+		t.Fatalf("Expected %d holidays, got %d", expected, len(sorted))
+	}
+
+	for i := 1; i < len(sorted); i++ {
+		if !sorted[i-1].Date.Before(sorted[i].Date) {
+			t.Errorf("Holidays out of order: %s (%s) before %s (%s)",
+				sorted[i-1].Name, sorted[i-1].Date.Format("2006-01-02"),
+				sorted[i].Name, sorted[i].Date.Format("2006-01-02"))
+		}
+	}
+
+	if len(sorted) > 0 && sorted[0].Name != "New Year's Day" {
+		t.Errorf("Expected New Year's Day first, got %s", sorted[0].Name)
+	}
+}
+
+func TestSortedHolidaysForDateRange(t *testing.T) {
+	us := NewCountry("US")
+	start := time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	sorted := us.SortedHolidaysForDateRange(start, end)
+	if expected := len(us.HolidaysForDateRange(start, end)); len(sorted) != expected {
+		t.Fatalf("Expected %d holidays, got %d", expected, len(sorted))
+	}
+
+	var names []string
+	for _, holiday := range sorted {
+		names = append(names, holiday.Name)
+	}
+	if len(names) < 4 || names[0] != "Veterans Day" || names[1] != "Thanksgiving Day" ||
+		names[2] != "Christmas Day" || names[3] != "New Year's Day" {
+		t.Errorf("Expected Veterans Day, Thanksgiving Day, Christmas Day and New Year's Day first, got %v", names)
+	}
+}
+
+func TestIterateHolidays(t *testing.T) {
+	us := NewCountry("US")
+	start := time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	var iterated []*Holiday
+	us.IterateHolidays(start, end, func(date time.Time, h *Holiday) bool {
+		if !date.Equal(h.Date) {
+			t.Errorf("Date %s does not match holiday date %s", date, h.Date)
+		}
+		iterated = append(iterated, h)
+		return true
+	})
+	sorted := us.SortedHolidaysForDateRange(start, end)
+	if len(iterated) != len(sorted) {
+		t.Fatalf("Expected %d holidays, got %d", len(sorted), len(iterated))
+	}
+	for i := range sorted {
+		if iterated[i] != sorted[i] {
+			t.Errorf("Holiday %d: expected %s, got %s", i, sorted[i].Name, iterated[i].Name)
+		}
+	}
+
+	// Stopping early leaves later years unloaded
+	lazy := NewCountry("US")
+	calls := 0
+	lazy.IterateHolidays(time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2200, 12, 31, 0, 0, 0, 0, time.UTC),
+		func(time.Time, *Holiday) bool {
+			calls++
+			return calls < 2
+		})
+	if calls != 2 {
+		t.Errorf("Expected iteration to stop after 2 holidays, got %d", calls)
+	}
+	if years := lazy.CacheStats().Years; years != 1 {
+		t.Errorf("Expected only 1900 to be loaded, got %d years", years)
+	}
+
+	// A bounded cache keeps memory flat over a long range
+	bounded := NewCountry("US", CountryOptions{MaxCachedYears: 2})
+	count := 0
+	bounded.IterateHolidays(time.Date(1950, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(1999, 12, 31, 0, 0, 0, 0, time.UTC),
+		func(time.Time, *Holiday) bool {
+			count++
+			return true
+		})
+	if count == 0 {
+		t.Error("Expected holidays between 1950 and 1999")
+	}
+	if years := bounded.CacheStats().Years; years > 2 {
+		t.Errorf("Expected at most 2 cached years, got %d", years)
+	}
+}
+
+func TestHolidaysForFiscalYear(t *testing.T) {
+	gb := NewCountry("GB")
+	start := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	holidays := gb.HolidaysForFiscalYear(time.April, 2024)
+	expected := 0
+	for _, year := range []int{2024, 2025} {
+		for date := range gb.HolidaysForYear(year) {
+			if !date.Before(start) && !date.After(end) {
+				expected++
+			}
+		}
+	}
+	if len(holidays) != expected {
+		t.Errorf("Expected %d holidays from April 2024 to March 2025, got %d", expected, len(holidays))
+	}
+
+	if _, ok := holidays[time.Date(2024, 3, 29, 0, 0, 0, 0, time.UTC)]; ok {
+		t.Error("Good Friday 2024 falls before the fiscal year")
+	}
+	if _, ok := holidays[time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)]; !ok {
+		t.Error("Expected New Year's Day 2025 in the fiscal year starting April 2024")
+	}
+
+	sorted := gb.SortedHolidaysForFiscalYear(time.April, 2024)
+	if len(sorted) != len(holidays) {
+		t.Fatalf("Expected %d sorted holidays, got %d", len(holidays), len(sorted))
+	}
+	if sorted[0].Name != "Easter Monday" || !sorted[0].Date.Equal(start) {
+		t.Errorf("Expected Easter Monday on April 1, 2024 first, got %s on %s", sorted[0].Name, sorted[0].Date.Format("2006-01-02"))
+	}
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i].Date.Before(sorted[i-1].Date) {
+			t.Errorf("Holidays out of order: %s before %s", sorted[i-1].Name, sorted[i].Name)
+		}
+	}
+
+	// A fiscal year starting in January is the calendar year
+	if got, want := len(gb.HolidaysForFiscalYear(time.January, 2024)), len(gb.HolidaysForYear(2024)); got != want {
+		t.Errorf("Expected %d holidays for a January fiscal year, got %d", want, got)
+	}
+	if got := gb.HolidaysForFiscalYear(13, 2024); len(got) != 0 {
+		t.Errorf("Expected no holidays for an invalid start month, got %d", len(got))
+	}
+}
+
+func TestHolidayDates(t *testing.T) {
+	day := func(year int, month time.Month, d int) time.Time {
+		return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name     string
+		country  string
+		year     int
+		holiday  string
+		expected []time.Time
+	}{
+		// Independence Day 2021 fell on a Sunday and was observed on Monday
+		{"US observed", "US", 2021, "Independence Day", []time.Time{day(2021, 7, 4), day(2021, 7, 5)}},
+		{"US weekday", "US", 2024, "Independence Day", []time.Time{day(2024, 7, 4)}},
+		// Boxing Day 2021 fell on a Sunday and its substitute day was Tuesday
+		{"GB substitute", "GB", 2021, "Boxing Day", []time.Time{day(2021, 12, 26), day(2021, 12, 28)}},
+		{"unknown", "US", 2024, "No Such Holiday", []time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dates := NewCountry(tt.country).HolidayDates(tt.year, tt.holiday)
+			if len(dates) != len(tt.expected) {
+				t.Fatalf("Expected %v, got %v", tt.expected, dates)
+			}
+			for i, date := range tt.expected {
+				if !dates[i].Equal(date) {
+					t.Errorf("Expected %v, got %v", tt.expected, dates)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestSubdivisionHolidays(t *testing.T) {
+	chavezDay := time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	national := NewCountry("US")
+	if _, isHoliday := national.IsHoliday(chavezDay); isHoliday {
+		t.Error("Cesar Chavez Day should not be a national holiday")
+	}
+
+	california := NewCountry("US", CountryOptions{Subdivisions: []string{"CA"}})
+	holiday, isHoliday := california.IsHoliday(chavezDay)
+	if !isHoliday || holiday.Name != "Cesar Chavez Day" {
+		t.Errorf("Expected Cesar Chavez Day in California, got %v", holiday)
+	}
+	if got, expected := len(california.HolidaysForYear(2024)), len(national.HolidaysForYear(2024))+1; got != expected {
+		t.Errorf("Expected %d holidays in California, got %d", expected, got)
+	}
+
+	for _, subdivisions := range [][]string{{}, {"ZZ"}} {
+		country := NewCountry("US", CountryOptions{Subdivisions: subdivisions})
+		if got, expected := len(country.HolidaysForYear(2024)), len(national.HolidaysForYear(2024)); got != expected {
+			t.Errorf("Subdivisions %v: expected only the %d national holidays, got %d", subdivisions, expected, got)
+		}
+	}
+
+	// Easter Sunday is a holiday in Brandenburg only
+	easter := time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)
+	if _, isHoliday := NewCountry("DE").IsHoliday(easter); isHoliday {
+		t.Error("Easter Sunday should not be a national holiday in Germany")
+	}
+	if _, isHoliday := NewCountry("DE", CountryOptions{Subdivisions: []string{"BB"}}).IsHoliday(easter); !isHoliday {
+		t.Error("Expected Easter Sunday to be a holiday in Brandenburg")
+	}
+}
+
+func TestHolidayYearRanges(t *testing.T) {
+	hasHoliday := func(country *Country, year int, name string) bool {
+		for _, holiday := range country.HolidaysForYear(year) {
+			if holiday.Name == name {
+				return true
+			}
+		}
+		return false
+	}
+
+	us := NewCountry("US")
+	if hasHoliday(us, 1980, "Martin Luther King Jr. Day") {
+		t.Error("Expected no Martin Luther King Jr. Day in 1980")
+	}
+	if !hasHoliday(us, 1990, "Martin Luther King Jr. Day") {
+		t.Error("Expected Martin Luther King Jr. Day in 1990")
+	}
+	if holiday, _ := us.IsHoliday(time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)); holiday == nil || holiday.FromYear != 1983 {
+		t.Errorf("Expected Martin Luther King Jr. Day to report its first year, got %+v", holiday)
+	}
+
+	nz := NewCountry("NZ")
+	if hasHoliday(nz, 2021, "Matariki") || !hasHoliday(nz, 2022, "Matariki") {
+		t.Error("Expected Matariki from 2022")
+	}
+
+	india := NewCountry("IN")
+	if hasHoliday(india, 1940, "Republic Day") || hasHoliday(india, 1940, "Independence Day") {
+		t.Error("Expected no Republic Day or Independence Day in 1940")
+	}
+	if hasHoliday(india, 1948, "Republic Day") || !hasHoliday(india, 1948, "Independence Day") {
+		t.Error("Expected Independence Day but no Republic Day in 1948")
+	}
+
+	// Custom holidays honour their range too
+	custom := NewCountry("US")
+	custom.AddCustomHoliday(time.Date(2019, 3, 1, 0, 0, 0, 0, time.UTC), &Holiday{Name: "Founders' Day", Category: CategoryPublic, FromYear: 2020})
+	if hasHoliday(custom, 2019, "Founders' Day") {
+		t.Error("Expected a custom holiday before its first year to be skipped")
+	}
+}
+
+func TestMultipleSubdivisions(t *testing.T) {
+	date := func(month time.Month, day int) time.Time {
+		return time.Date(2024, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	national := NewCountry("DE")
+	saxony := NewCountry("DE", CountryOptions{Subdivisions: []string{"SN"}})
+	thuringia := NewCountry("DE", CountryOptions{Subdivisions: []string{"TH"}})
+	bavaria := NewCountry("DE", CountryOptions{Subdivisions: []string{"BY"}})
+	union := NewCountry("DE", CountryOptions{Subdivisions: []string{"SN", "TH", "BY"}})
+
+	// The union holds each national holiday once, plus each date any subdivision adds
+	expected := make(map[time.Time]bool)
+	for _, country := range []*Country{saxony, thuringia, bavaria} {
+		for day := range country.HolidaysForYear(2024) {
+			expected[day] = true
+		}
+	}
+	if got := len(union.HolidaysForYear(2024)); got != len(expected) {
+		t.Errorf("Expected %d holidays for SN, TH and BY, got %d", len(expected), got)
+	}
+	for day, holiday := range national.HolidaysForYear(2024) {
+		if got, _ := union.IsHoliday(day); got == nil || got.Name != holiday.Name {
+			t.Errorf("Expected national %s to keep %s, got %v", holiday.Name, day.Format("2006-01-02"), got)
+		}
+	}
+
+	// Reformation Day is observed in Saxony and Thuringia: one holiday for both
+	reformation := union.HolidaysOn(date(time.October, 31))
+	if len(reformation) != 1 || reformation[0].Name != "Reformationstag" {
+		t.Fatalf("Expected a single Reformationstag, got %v", reformation)
+	}
+	if subdivisions := reformation[0].Subdivisions; len(subdivisions) != 2 || subdivisions[0] != "SN" || subdivisions[1] != "TH" {
+		t.Errorf("Expected Reformationstag in SN and TH, got %v", subdivisions)
+	}
+
+	// Holidays of a single subdivision list only that subdivision
+	for day, subdivision := range map[time.Time]string{date(time.November, 20): "SN", date(time.August, 15): "BY"} {
+		holiday, isHoliday := union.IsHoliday(day)
+		if !isHoliday || len(holiday.Subdivisions) != 1 || holiday.Subdivisions[0] != subdivision {
+			t.Errorf("Expected a %s holiday on %s, got %v", subdivision, day.Format("2006-01-02"), holiday)
+		}
+	}
+
+	// Listing a subdivision twice changes nothing
+	twice := NewCountry("DE", CountryOptions{Subdivisions: []string{"SN", "SN"}})
+	if got, want := len(twice.HolidaysForYear(2024)), len(saxony.HolidaysForYear(2024)); got != want {
+		t.Errorf("Expected %d holidays with SN listed twice, got %d", want, got)
+	}
+	if holiday, _ := twice.IsHoliday(date(time.October, 31)); len(holiday.Subdivisions) != 1 {
+		t.Errorf("Expected Reformationstag listed once for SN, got %v", holiday.Subdivisions)
+	}
+}
+
+func TestDetectDuplicates(t *testing.T) {
+	// Mirror a provider whose national and inline loaders both add Christmas under different names
+	us := NewCountry("US")
+	us.HolidaysForYear(2024)
+	christmas := time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC)
+	us.mu.Lock()
+	us.addHoliday(2024, &Holiday{Name: "Christmas", Date: christmas, Category: CategoryPublic})
+	us.mu.Unlock()
+
+	warnings := us.DetectDuplicates(2024)
+	if len(warnings) != 1 {
+		t.Fatalf("Expected 1 duplicate warning, got %v", warnings)
+	}
+	if !warnings[0].Date.Equal(christmas) {
+		t.Errorf("Expected the duplicate on %s, got %s", christmas.Format("2006-01-02"), warnings[0].Date.Format("2006-01-02"))
+	}
+	if len(warnings[0].Names) != 2 || warnings[0].Names[0] != "Christmas Day" || warnings[0].Names[1] != "Christmas" {
+		t.Errorf("Expected names [Christmas Day Christmas], got %v", warnings[0].Names)
+	}
+
+	if warnings := NewCountry("US").DetectDuplicates(2024); len(warnings) != 0 {
+		t.Errorf("Expected no duplicates for the US provider, got %v", warnings)
+	}
+}
+
+func TestFromProviderHoliday(t *testing.T) {
+	date := time.Date(2022, 12, 25, 0, 0, 0, 0, time.UTC)
+	observed := time.Date(2022, 12, 27, 0, 0, 0, 0, time.UTC)
+	provider := countries.Holiday{
+		Name:          "Christmas Day",
+		Date:          date,
+		Category:      "bank",
+		Observed:      &observed,
+		Languages:     map[string]string{"en": "Christmas Day", "cy": "Dydd Nadolig"},
+		IsObserved:    true,
+		Subdivisions:  []string{"ENG", "WLS"},
+		SubstituteFor: "Christmas",
+		HasSubstitute: true,
+	}
+
+	expected := &Holiday{
+		Name:          "Christmas Day",
+		Date:          date,
+		Category:      CategoryBank,
+		Observed:      &observed,
+		Languages:     map[string]string{"en": "Christmas Day", "cy": "Dydd Nadolig"},
+		IsObserved:    true,
+		Subdivisions:  []string{"ENG", "WLS"},
+		SubstituteFor: "Christmas",
+		HasSubstitute: true,
+	}
+
+	if got := fromProviderHoliday(provider); !reflect.DeepEqual(got, expected) {
+		t.Errorf("fromProviderHoliday() = %+v, expected %+v", got, expected)
+	}
+}
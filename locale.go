@@ -0,0 +1,98 @@
+package goholidays
+
+import (
+	"time"
+
+	"github.com/coredds/goholiday/countries"
+)
+
+// monthNames holds localized month names, indexed by time.Month-1
+var monthNames = map[string][12]string{
+	"en": {"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	"fr": {"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+	"es": {"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+	"de": {"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+	"pt": {"janeiro", "fevereiro", "março", "abril", "maio", "junho", "julho", "agosto", "setembro", "outubro", "novembro", "dezembro"},
+	"it": {"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno", "luglio", "agosto", "settembre", "ottobre", "novembre", "dicembre"},
+	"nl": {"januari", "februari", "maart", "april", "mei", "juni", "juli", "augustus", "september", "oktober", "november", "december"},
+	"ja": {"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+	"zh": {"一月", "二月", "三月", "四月", "五月", "六月", "七月", "八月", "九月", "十月", "十一月", "十二月"},
+	"ko": {"1월", "2월", "3월", "4월", "5월", "6월", "7월", "8월", "9월", "10월", "11월", "12월"},
+}
+
+// weekdayAbbrevs holds two-column weekday abbreviations, indexed by time.Weekday
+var weekdayAbbrevs = map[string][7]string{
+	"en": {"Su", "Mo", "Tu", "We", "Th", "Fr", "Sa"},
+	"fr": {"di", "lu", "ma", "me", "je", "ve", "sa"},
+	"es": {"do", "lu", "ma", "mi", "ju", "vi", "sá"},
+	"de": {"So", "Mo", "Di", "Mi", "Do", "Fr", "Sa"},
+	"pt": {"do", "sg", "te", "qa", "qi", "sx", "sá"},
+	"it": {"do", "lu", "ma", "me", "gi", "ve", "sa"},
+	"nl": {"zo", "ma", "di", "wo", "do", "vr", "za"},
+	"ja": {"日", "月", "火", "水", "木", "金", "土"},
+	"zh": {"日", "一", "二", "三", "四", "五", "六"},
+	"ko": {"일", "월", "화", "수", "목", "금", "토"},
+}
+
+// MonthName returns the name of month in the given language, falling back to English
+func MonthName(month time.Month, language string) string {
+	if month < time.January || month > time.December {
+		return month.String()
+	}
+	names, ok := monthNames[language]
+	if !ok {
+		names = monthNames["en"]
+	}
+	return names[month-1]
+}
+
+// WeekdayAbbrev returns a two-column abbreviation of day in the given language, falling back to English
+func WeekdayAbbrev(day time.Weekday, language string) string {
+	if day < time.Sunday || day > time.Saturday {
+		return day.String()
+	}
+	abbrevs, ok := weekdayAbbrevs[language]
+	if !ok {
+		abbrevs = weekdayAbbrevs["en"]
+	}
+	return abbrevs[day]
+}
+
+// LocalName returns the holiday's name in lang, falling back to English and then to Name
+func (h *Holiday) LocalName(lang string) string {
+	return h.firstName(lang, countries.DefaultLanguage)
+}
+
+// firstName returns the holiday's name in the first of languages it has one in, or Name
+func (h *Holiday) firstName(languages ...string) string {
+	for _, lang := range languages {
+		if name := h.Languages[lang]; name != "" {
+			return name
+		}
+	}
+	return h.Name
+}
+
+// SetLanguageFallback sets the languages HolidayName tries, in order, when a holiday has
+// no name in the requested language, e.g. de, fr and it for Switzerland. By default it
+// tries the country's configured language; nil restores that.
+func (c *Country) SetLanguageFallback(languages []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.languageFallback = append([]string(nil), languages...)
+}
+
+// HolidayName returns a holiday's name in lang, falling back to the languages set with
+// SetLanguageFallback (by default the country's language), then English, then Name
+func (c *Country) HolidayName(holiday *Holiday, lang string) string {
+	c.mu.RLock()
+	fallback := c.languageFallback
+	c.mu.RUnlock()
+
+	if len(fallback) == 0 {
+		fallback = []string{c.language}
+	}
+	languages := append([]string{lang}, fallback...)
+	return holiday.firstName(append(languages, countries.DefaultLanguage)...)
+}
@@ -0,0 +1,120 @@
+package goholidays
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMonthName(t *testing.T) {
+	tests := []struct {
+		month    time.Month
+		language string
+		expected string
+	}{
+		{time.March, "fr", "mars"},
+		{time.December, "de", "Dezember"},
+		{time.August, "pt", "agosto"},
+		{time.May, "ja", "5月"},
+		{time.March, "en", "March"},
+		{time.March, "xx", "March"},
+	}
+
+	for _, tt := range tests {
+		if got := MonthName(tt.month, tt.language); got != tt.expected {
+			t.Errorf("MonthName(%v, %q) = %q, expected %q", tt.month, tt.language, got, tt.expected)
+		}
+	}
+}
+
+func TestWeekdayAbbrev(t *testing.T) {
+	if got := WeekdayAbbrev(time.Monday, "fr"); got != "lu" {
+		t.Errorf("Expected French Monday to be lu, got %q", got)
+	}
+	if got := WeekdayAbbrev(time.Sunday, "xx"); got != "Su" {
+		t.Errorf("Expected unknown languages to fall back to English, got %q", got)
+	}
+}
+
+func TestRenderMonthLocalized(t *testing.T) {
+	fr := NewCountry("FR", CountryOptions{Language: "fr"})
+	calendar := NewHolidayCalendar(fr)
+
+	var buf bytes.Buffer
+	calendar.RenderMonth(&buf, 2024, time.July)
+	output := buf.String()
+
+	if !strings.Contains(output, "juillet 2024\n") {
+		t.Errorf("Expected French month header, got:\n%s", output)
+	}
+	if !strings.Contains(output, "di lu ma me je ve sa\n") {
+		t.Errorf("Expected French weekday header, got:\n%s", output)
+	}
+	if !strings.Contains(output, "*4") {
+		t.Errorf("Expected Bastille Day (July 14) to be marked, got:\n%s", output)
+	}
+
+	buf.Reset()
+	NewHolidayCalendar(NewCountry("US")).RenderMonth(&buf, 2024, time.July)
+	if !strings.Contains(buf.String(), "July 2024\nSu Mo Tu We Th Fr Sa\n") {
+		t.Errorf("Expected English header, got:\n%s", buf.String())
+	}
+}
+
+func TestLocalName(t *testing.T) {
+	holiday := &Holiday{
+		Name:      "Bundesfeier",
+		Languages: map[string]string{"en": "Swiss National Day", "fr": "Fête nationale", "it": "Festa nazionale"},
+	}
+
+	tests := []struct {
+		lang     string
+		expected string
+	}{
+		{"fr", "Fête nationale"},
+		{"rm", "Swiss National Day"},
+		{"", "Swiss National Day"},
+	}
+	for _, tt := range tests {
+		if got := holiday.LocalName(tt.lang); got != tt.expected {
+			t.Errorf("LocalName(%q) = %q, expected %q", tt.lang, got, tt.expected)
+		}
+	}
+	if got := (&Holiday{Name: "Local Day"}).LocalName("fr"); got != "Local Day" {
+		t.Errorf("Expected Name without translations, got %q", got)
+	}
+}
+
+func TestHolidayName(t *testing.T) {
+	holiday := &Holiday{
+		Name:      "Bundesfeier",
+		Languages: map[string]string{"en": "Swiss National Day", "fr": "Fête nationale", "it": "Festa nazionale"},
+	}
+
+	// The country's language is tried before English
+	ch := NewCountry("CH", CountryOptions{Language: "it"})
+	if got := ch.HolidayName(holiday, "rm"); got != "Festa nazionale" {
+		t.Errorf("Expected the country's language, got %q", got)
+	}
+	if got := ch.HolidayName(holiday, "fr"); got != "Fête nationale" {
+		t.Errorf("Expected the requested language first, got %q", got)
+	}
+
+	ch.SetLanguageFallback([]string{"de", "fr", "it"})
+	if got := ch.HolidayName(holiday, "rm"); got != "Fête nationale" {
+		t.Errorf("Expected the first fallback with a name, got %q", got)
+	}
+	ch.SetLanguageFallback([]string{"de"})
+	if got := ch.HolidayName(holiday, "rm"); got != "Swiss National Day" {
+		t.Errorf("Expected English after the fallbacks, got %q", got)
+	}
+	ch.SetLanguageFallback(nil)
+	if got := ch.HolidayName(holiday, "rm"); got != "Festa nazionale" {
+		t.Errorf("Expected nil to restore the country's language, got %q", got)
+	}
+
+	if got := ch.HolidayName(&Holiday{Name: "Bundesfeier"}, "fr"); got != "Bundesfeier" {
+		t.Errorf("Expected Name without translations, got %q", got)
+	}
+}
@@ -0,0 +1,144 @@
+package goholidays
+
+import (
+	"fmt"
+	"time"
+)
+
+// defaultNextHolidayLookahead bounds how many years past the start date are searched
+// unless CountryOptions.NextHolidayLookahead says otherwise
+const defaultNextHolidayLookahead = 5
+
+// NextHoliday returns the first holiday on or after date and the date it falls on,
+// searching forward across year boundaries. Only the country's configured categories and
+// subdivisions count. The search covers the configured lookahead (5 years by default,
+// see CountryOptions.NextHolidayLookahead); ok is false when nothing falls within it.
+func (c *Country) NextHoliday(date time.Time) (*Holiday, time.Time, bool) {
+	return c.nextHoliday(date, c.lookahead)
+}
+
+// PreviousHoliday returns the last holiday on or before date and the date it falls on,
+// searching backward across year boundaries with the same bound as NextHoliday
+func (c *Country) PreviousHoliday(date time.Time) (*Holiday, time.Time, bool) {
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+
+	for year := day.Year(); year >= day.Year()-c.lookahead; year-- {
+		var previous *Holiday
+		var previousDate time.Time
+		for holidayDate, holiday := range c.HolidaysForYear(year) {
+			if holidayDate.After(day) {
+				continue
+			}
+			if previous == nil || holidayDate.After(previousDate) {
+				previous, previousDate = holiday, holidayDate
+			}
+		}
+		if previous != nil {
+			return previous, previousDate, true
+		}
+	}
+
+	return nil, time.Time{}, false
+}
+
+// nextHoliday returns the first holiday on or after from and its date, searching forward
+// across year boundaries for at most lookahead years past from's year
+func (c *Country) nextHoliday(from time.Time, lookahead int) (*Holiday, time.Time, bool) {
+	day := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+
+	for year := day.Year(); year <= day.Year()+lookahead; year++ {
+		var next *Holiday
+		var nextDate time.Time
+		for date, holiday := range c.HolidaysForYear(year) {
+			if date.Before(day) {
+				continue
+			}
+			if next == nil || date.Before(nextDate) {
+				next, nextDate = holiday, date
+			}
+		}
+		if next != nil {
+			return next, nextDate, true
+		}
+	}
+
+	return nil, time.Time{}, false
+}
+
+// DaysUntilNextHoliday returns the number of calendar days from from until the next holiday.
+// If from is itself a holiday, days is 0. The search covers the country's configured
+// lookahead (see CountryOptions.NextHolidayLookahead); ok is false when nothing falls within it.
+func (c *Country) DaysUntilNextHoliday(from time.Time) (days int, holiday *Holiday, ok bool) {
+	days, holiday, err := c.DaysUntilNextHolidayWithin(from, c.lookahead)
+	return days, holiday, err == nil
+}
+
+// DaysUntilNextHolidayWithin is like DaysUntilNextHoliday but searches at most years years
+// past from's year. It returns an ErrHolidayNotFound error when no holiday falls within them.
+func (c *Country) DaysUntilNextHolidayWithin(from time.Time, years int) (int, *Holiday, error) {
+	if years < 0 {
+		return 0, nil, NewHolidayError(ErrInvalidYear,
+			fmt.Sprintf("lookahead must not be negative, got %d", years))
+	}
+
+	holiday, date, ok := c.nextHoliday(from, years)
+	if !ok {
+		return 0, nil, &HolidayError{
+			Code:    ErrHolidayNotFound,
+			Country: c.code,
+			Date:    from.Format("2006-01-02"),
+			Message: fmt.Sprintf("no holiday found within %d years of %s", years, from.Format("2006-01-02")),
+		}
+	}
+
+	day := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	days := int(date.Sub(day).Hours() / 24)
+	return days, holiday, nil
+}
+
+// NextNamedHoliday returns the first occurrence on or after from of the holiday with the
+// given name, matched case- and accent-insensitively against its name and translations.
+// Like DaysUntilNextHoliday it searches the country's configured lookahead and reports
+// false when nothing matches.
+func (c *Country) NextNamedHoliday(name string, from time.Time) (*Holiday, bool) {
+	query := normalizeHolidayName(name)
+	day := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+
+	for year := day.Year(); year <= day.Year()+c.lookahead; year++ {
+		for _, primary := range c.SortedHolidaysForYear(year) {
+			if primary.Date.Before(day) {
+				continue
+			}
+			for _, holiday := range c.HolidaysOn(primary.Date) {
+				if matchesHolidayName(holiday, query) {
+					return holiday, true
+				}
+			}
+		}
+	}
+
+	return nil, false
+}
+
+// HasHolidayInRange reports whether any holiday falls between start and end inclusive.
+// It stops at the first match instead of collecting the whole range.
+func (c *Country) HasHolidayInRange(start, end time.Time) bool {
+	if end.Before(start) {
+		return false
+	}
+
+	for year := start.Year(); year <= end.Year(); year++ {
+		holidays := c.loadYear(year)
+
+		c.mu.RLock()
+		for date := range holidays {
+			if !date.Before(start) && !date.After(end) {
+				c.mu.RUnlock()
+				return true
+			}
+		}
+		c.mu.RUnlock()
+	}
+
+	return false
+}
@@ -0,0 +1,249 @@
+package goholidays
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDaysUntilNextHoliday(t *testing.T) {
+	us := NewCountry("US")
+
+	tests := []struct {
+		name         string
+		from         time.Time
+		expectedDays int
+		expectedName string
+	}{
+		{"Mid June", time.Date(2024, 6, 20, 0, 0, 0, 0, time.UTC), 14, "Independence Day"},
+		{"On Holiday", time.Date(2024, 7, 4, 15, 30, 0, 0, time.UTC), 0, "Independence Day"},
+		{"Across Year Boundary", time.Date(2024, 12, 26, 0, 0, 0, 0, time.UTC), 6, "New Year's Day"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			days, holiday, ok := us.DaysUntilNextHoliday(tt.from)
+			if !ok {
+				t.Fatal("Expected to find a next holiday")
+			}
+			if days != tt.expectedDays {
+				t.Errorf("Expected %d days, got %d", tt.expectedDays, days)
+			}
+			if holiday.Name != tt.expectedName {
+				t.Errorf("Expected %s, got %s", tt.expectedName, holiday.Name)
+			}
+		})
+	}
+}
+
+func TestHasHolidayInRange(t *testing.T) {
+	us := NewCountry("US")
+
+	tests := []struct {
+		name     string
+		start    time.Time
+		end      time.Time
+		expected bool
+	}{
+		{"contains Independence Day", time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 7, 10, 0, 0, 0, 0, time.UTC), true},
+		{"no holidays", time.Date(2024, 7, 5, 0, 0, 0, 0, time.UTC), time.Date(2024, 7, 31, 0, 0, 0, 0, time.UTC), false},
+		{"inclusive start", time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC), time.Date(2024, 12, 26, 0, 0, 0, 0, time.UTC), true},
+		{"inclusive end", time.Date(2024, 12, 20, 0, 0, 0, 0, time.UTC), time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC), true},
+		{"across years", time.Date(2024, 12, 26, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 5, 0, 0, 0, 0, time.UTC), true},
+		{"reversed range", time.Date(2024, 7, 10, 0, 0, 0, 0, time.UTC), time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := us.HasHolidayInRange(tt.start, tt.end); got != tt.expected {
+				t.Errorf("HasHolidayInRange(%s, %s) = %v, expected %v",
+					tt.start.Format("2006-01-02"), tt.end.Format("2006-01-02"), got, tt.expected)
+			}
+		})
+	}
+}
+
+func BenchmarkHasHolidayInRange(b *testing.B) {
+	us := NewCountry("US")
+	start := time.Date(1950, 1, 2, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2050, 12, 31, 0, 0, 0, 0, time.UTC)
+	us.HolidaysForDateRange(start, end) // Warm the cache so both benchmarks measure lookups only
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		us.HasHolidayInRange(start, end)
+	}
+}
+
+func BenchmarkHolidaysForDateRangeNonEmpty(b *testing.B) {
+	us := NewCountry("US")
+	start := time.Date(1950, 1, 2, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2050, 12, 31, 0, 0, 0, 0, time.UTC)
+	us.HolidaysForDateRange(start, end)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = len(us.HolidaysForDateRange(start, end)) > 0
+	}
+}
+
+func TestNextHolidayLookahead(t *testing.T) {
+	// A fifth Friday of February only occurs in leap years starting on a Friday: 2008, then 2036
+	rule := NthWeekdayRule(time.Friday, 5, time.February)
+	from := time.Date(2009, 1, 1, 0, 0, 0, 0, time.UTC)
+	expected := time.Date(2036, 2, 29, 0, 0, 0, 0, time.UTC)
+
+	sparse := NewCountry("XX")
+	sparse.AddRecurringHoliday("Leap Friday", rule, CategoryPublic)
+
+	if _, _, ok := sparse.DaysUntilNextHoliday(from); ok {
+		t.Error("Expected no holiday within the default lookahead")
+	}
+
+	_, _, err := sparse.DaysUntilNextHolidayWithin(from, 10)
+	if err == nil {
+		t.Fatal("Expected an error when no holiday falls within 10 years")
+	}
+	if !errors.Is(err, &HolidayError{Code: ErrHolidayNotFound}) {
+		t.Errorf("Expected ErrHolidayNotFound, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "within 10 years") {
+		t.Errorf("Expected the error to name the lookahead, got %q", err.Error())
+	}
+
+	days, holiday, err := sparse.DaysUntilNextHolidayWithin(from, 30)
+	if err != nil {
+		t.Fatalf("Expected a holiday within 30 years, got %v", err)
+	}
+	if !holiday.Date.Equal(expected) {
+		t.Errorf("Expected next holiday on %s, got %s", expected.Format("2006-01-02"), holiday.Date.Format("2006-01-02"))
+	}
+	if want := int(expected.Sub(from).Hours() / 24); days != want {
+		t.Errorf("Expected %d days, got %d", want, days)
+	}
+
+	configured := NewCountry("XX", CountryOptions{NextHolidayLookahead: 30})
+	configured.AddRecurringHoliday("Leap Friday", rule, CategoryPublic)
+	if _, holiday, ok := configured.DaysUntilNextHoliday(from); !ok || !holiday.Date.Equal(expected) {
+		t.Errorf("Expected the configured lookahead to find %s, got %v", expected.Format("2006-01-02"), holiday)
+	}
+
+	if _, _, err := sparse.DaysUntilNextHolidayWithin(from, -1); err == nil {
+		t.Error("Expected an error for a negative lookahead")
+	}
+}
+
+func TestNextNamedHoliday(t *testing.T) {
+	us := NewCountry("US")
+
+	tests := []struct {
+		name     string
+		holiday  string
+		from     time.Time
+		expected time.Time
+	}{
+		{"From March", "Thanksgiving Day", time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC), time.Date(2024, 11, 28, 0, 0, 0, 0, time.UTC)},
+		{"On the day", "thanksgiving day", time.Date(2024, 11, 28, 12, 0, 0, 0, time.UTC), time.Date(2024, 11, 28, 0, 0, 0, 0, time.UTC)},
+		{"After this year's", "Thanksgiving Day", time.Date(2024, 11, 29, 0, 0, 0, 0, time.UTC), time.Date(2025, 11, 27, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			holiday, ok := us.NextNamedHoliday(tt.holiday, tt.from)
+			if !ok {
+				t.Fatalf("Expected to find %s", tt.holiday)
+			}
+			if !holiday.Date.Equal(tt.expected) {
+				t.Errorf("Expected %s, got %s", tt.expected.Format("2006-01-02"), holiday.Date.Format("2006-01-02"))
+			}
+		})
+	}
+
+	if _, ok := us.NextNamedHoliday("No Such Holiday", time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)); ok {
+		t.Error("Expected no match for an unknown holiday")
+	}
+}
+
+func TestNextAndPreviousHoliday(t *testing.T) {
+	us := NewCountry("US")
+
+	tests := []struct {
+		name         string
+		from         time.Time
+		nextName     string
+		nextDate     time.Time
+		previousName string
+		previousDate time.Time
+	}{
+		{
+			"Mid June", time.Date(2024, 6, 20, 9, 0, 0, 0, time.UTC),
+			"Independence Day", time.Date(2024, 7, 4, 0, 0, 0, 0, time.UTC),
+			"Juneteenth", time.Date(2024, 6, 19, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			"On a holiday", time.Date(2024, 7, 4, 0, 0, 0, 0, time.UTC),
+			"Independence Day", time.Date(2024, 7, 4, 0, 0, 0, 0, time.UTC),
+			"Independence Day", time.Date(2024, 7, 4, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			"Across year boundaries", time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC),
+			"New Year's Day", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			"Christmas Day", time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			holiday, date, ok := us.NextHoliday(tt.from)
+			if !ok || holiday.Name != tt.nextName || !date.Equal(tt.nextDate) {
+				t.Errorf("NextHoliday() = %v on %s, expected %s on %s", holiday, date.Format("2006-01-02"), tt.nextName, tt.nextDate.Format("2006-01-02"))
+			}
+
+			holiday, date, ok = us.PreviousHoliday(tt.from)
+			if !ok || holiday.Name != tt.previousName || !date.Equal(tt.previousDate) {
+				t.Errorf("PreviousHoliday() = %v on %s, expected %s on %s", holiday, date.Format("2006-01-02"), tt.previousName, tt.previousDate.Format("2006-01-02"))
+			}
+		})
+	}
+}
+
+func TestNextHolidayRespectsConfiguration(t *testing.T) {
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	// Cesar Chavez Day (March 31) only counts for California
+	if holiday, _, ok := NewCountry("US").NextHoliday(from); !ok || holiday.Name == "Cesar Chavez Day" {
+		t.Errorf("Expected a national holiday after %s, got %v", from.Format("2006-01-02"), holiday)
+	}
+	california := NewCountry("US", CountryOptions{Subdivisions: []string{"CA"}})
+	if holiday, _, ok := california.NextHoliday(from); !ok || holiday.Name != "Cesar Chavez Day" {
+		t.Errorf("Expected Cesar Chavez Day in California, got %v", holiday)
+	}
+
+	// GB bank holidays only: the first one of 2024 is the Early May Bank Holiday
+	bank := NewCountry("GB", CountryOptions{Categories: []HolidayCategory{CategoryBank}})
+	if holiday, date, ok := bank.NextHoliday(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)); !ok || holiday.Category != CategoryBank {
+		t.Errorf("Expected a bank holiday, got %v on %s", holiday, date.Format("2006-01-02"))
+	}
+}
+
+func TestNextHolidayBounded(t *testing.T) {
+	// A country without holidays must give up after the lookahead rather than loop forever
+	empty := NewCountry("XX")
+	if _, _, ok := empty.NextHoliday(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)); ok {
+		t.Error("Expected no next holiday for a country without holidays")
+	}
+	if _, _, ok := empty.PreviousHoliday(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)); ok {
+		t.Error("Expected no previous holiday for a country without holidays")
+	}
+}
+
+func BenchmarkNextHoliday(b *testing.B) {
+	us := NewCountry("US")
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		us.NextHoliday(from.AddDate(0, 0, i%365))
+	}
+}
@@ -0,0 +1,162 @@
+package goholidays
+
+import (
+	"sort"
+	"time"
+)
+
+// ObservanceShift controls which weekday a holiday falling on a weekend is observed on
+type ObservanceShift int
+
+const (
+	// ObservanceNone keeps the observed dates supplied by the country's provider
+	ObservanceNone ObservanceShift = iota
+	// ObservanceNearestWeekday observes Saturday holidays on the preceding Friday and
+	// Sunday holidays on the following Monday, as in the US
+	ObservanceNearestWeekday
+	// ObservanceFollowingWeekday observes Saturday and Sunday holidays on the following
+	// Monday, as in the UK
+	ObservanceFollowingWeekday
+)
+
+// ObservanceRule describes how holidays falling on a weekend are observed. A shifted
+// holiday moves on past weekends and dates already taken by another holiday, so two
+// weekend holidays never share an observed day.
+type ObservanceRule struct {
+	Shift ObservanceShift
+	// AddObservedDays makes each observed date a holiday in its own right, named
+	// "<holiday> (observed)", so IsHoliday reports it
+	AddObservedDays bool
+}
+
+// SetObservanceRule replaces the country's observance rule. Loaded years are discarded
+// and reloaded under the new rule on next access.
+func (c *Country) SetObservanceRule(rule ObservanceRule) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.observance = rule
+	c.resetYears()
+}
+
+// TodayStatus reports the holiday a date belongs to and whether the date is that
+// holiday's observed day rather than its actual one, so a UI can show "Christmas Day
+// (observed)". A date is an observed day when it is a substitute day loaded as a holiday
+// in its own right, or when a holiday's observed date points at it; in the latter case
+// the date counts as a holiday even though IsHoliday does not report it.
+func (c *Country) TodayStatus(t time.Time) (holiday *Holiday, isHoliday bool, isObservedDay bool) {
+	if holiday, found := c.IsHoliday(t); found {
+		return holiday, true, holiday.SubstituteFor != ""
+	}
+
+	// Holidays near the year boundary may be observed in the neighbouring year
+	date := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	for year := t.Year() - 1; year <= t.Year()+1; year++ {
+		for _, holiday := range c.SortedHolidaysForYear(year) {
+			if holiday.Observed != nil && holiday.Observed.Equal(date) {
+				return holiday, true, true
+			}
+		}
+	}
+	return nil, false, false
+}
+
+// applyObservanceRule sets the observed dates of a year being loaded and, if configured,
+// adds them as holidays (caller must hold the write lock). Holidays at the end of the
+// previous year and the start of the next are considered too, since they may be observed
+// across the year boundary, e.g. a Saturday New Year's Day observed on December 31.
+func (c *Country) applyObservanceRule(year int) {
+	if c.observance.Shift == ObservanceNone {
+		return
+	}
+
+	neighbours := c.withoutObservance()
+	var holidays []*Holiday
+	taken := make(map[time.Time]bool)
+	collect := func(loaded map[time.Time]*Holiday, extras map[time.Time][]*Holiday, keep func(time.Time) bool) {
+		for date, holiday := range loaded {
+			if !keep(date) {
+				continue
+			}
+			taken[date] = true
+			holidays = append(holidays, holiday)
+			holidays = append(holidays, extras[date]...)
+		}
+	}
+	collect(neighbours.HolidaysForYear(year-1), neighbours.extras[year-1], func(date time.Time) bool {
+		return date.Month() == time.December
+	})
+	collect(c.years[year], c.extras[year], func(time.Time) bool { return true })
+	collect(neighbours.HolidaysForYear(year+1), neighbours.extras[year+1], func(date time.Time) bool {
+		return date.Month() == time.January
+	})
+
+	sort.SliceStable(holidays, func(i, j int) bool {
+		return holidays[i].Date.Before(holidays[j].Date)
+	})
+
+	for _, holiday := range holidays {
+		if holiday.SubstituteFor != "" || holiday.HasSubstitute {
+			continue // Already given a substitute day by the provider or collision rule
+		}
+		observed, shifted := c.observedDate(holiday.Date, taken)
+		if !shifted {
+			continue
+		}
+		taken[observed] = true
+
+		if holiday.Date.Year() == year {
+			holiday.Observed = &observed
+			holiday.IsObserved = true
+			if c.observance.AddObservedDays {
+				holiday.HasSubstitute = true
+			}
+		}
+		if c.observance.AddObservedDays && observed.Year() == year {
+			name := holiday.Name + " (observed)"
+			c.addHoliday(year, &Holiday{
+				Name:          name,
+				Date:          observed,
+				Category:      holiday.Category,
+				Languages:     map[string]string{"en": name},
+				SubstituteFor: holiday.Name,
+			})
+		}
+	}
+}
+
+// observedDate returns the day a holiday on date is observed under the country's rule,
+// skipping weekends and dates in taken, and whether that differs from date
+func (c *Country) observedDate(date time.Time, taken map[time.Time]bool) (time.Time, bool) {
+	step := 1
+	switch date.Weekday() {
+	case time.Saturday:
+		if c.observance.Shift == ObservanceNearestWeekday {
+			step = -1
+		}
+	case time.Sunday:
+	default:
+		return date, false
+	}
+
+	observed := date.AddDate(0, 0, step)
+	for taken[observed] || observed.Weekday() == time.Saturday || observed.Weekday() == time.Sunday {
+		observed = observed.AddDate(0, 0, step)
+	}
+	return observed, true
+}
+
+// withoutObservance returns an empty Country with the same configuration but no
+// observance rule, for loading the unshifted holidays of neighbouring years. It keeps no
+// years and has no cache bound; its loads log no warnings, as the Country itself logs them.
+func (c *Country) withoutObservance() *Country {
+	clone := &Country{
+		countrySettings: c.countrySettings,
+		years:           make(map[int]map[time.Time]*Holiday),
+		extras:          make(map[int]map[time.Time][]*Holiday),
+	}
+	clone.observance = ObservanceRule{}
+	clone.checkLanguages = false
+	clone.checkCount = false
+	return clone
+}
@@ -0,0 +1,158 @@
+package goholidays
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestObservanceRuleNearestWeekday(t *testing.T) {
+	us := NewCountry("US")
+	us.SetObservanceRule(ObservanceRule{Shift: ObservanceNearestWeekday, AddObservedDays: true})
+
+	tests := []struct {
+		name     string
+		date     time.Time
+		observed time.Time
+	}{
+		// Saturday holidays move back to Friday, Sunday holidays forward to Monday
+		{"Christmas Day", time.Date(2021, 12, 25, 0, 0, 0, 0, time.UTC), time.Date(2021, 12, 24, 0, 0, 0, 0, time.UTC)},
+		{"Independence Day", time.Date(2021, 7, 4, 0, 0, 0, 0, time.UTC), time.Date(2021, 7, 5, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			holiday, isHoliday := us.IsHoliday(tt.date)
+			if !isHoliday || holiday.Name != tt.name {
+				t.Fatalf("Expected %s on %s, got %v", tt.name, tt.date.Format("2006-01-02"), holiday)
+			}
+			if !holiday.IsObserved || holiday.Observed == nil || !holiday.Observed.Equal(tt.observed) {
+				t.Errorf("Expected %s to be observed on %s, got %v", tt.name, tt.observed.Format("2006-01-02"), holiday.Observed)
+			}
+
+			observed, isHoliday := us.IsHoliday(tt.observed)
+			if !isHoliday || observed.SubstituteFor != tt.name {
+				t.Errorf("Expected %s to be a holiday observing %s, got %v", tt.observed.Format("2006-01-02"), tt.name, observed)
+			}
+		})
+	}
+
+	// New Year's Day 2022 fell on a Saturday and was observed on Friday, December 31, 2021
+	newYearsEve := time.Date(2021, 12, 31, 0, 0, 0, 0, time.UTC)
+	holiday, isHoliday := us.IsHoliday(newYearsEve)
+	if !isHoliday || holiday.SubstituteFor != "New Year's Day" {
+		t.Errorf("Expected New Year's Day 2022 to be observed on %s, got %v", newYearsEve.Format("2006-01-02"), holiday)
+	}
+	newYear, _ := us.IsHoliday(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	if newYear == nil || newYear.Observed == nil || !newYear.Observed.Equal(newYearsEve) {
+		t.Errorf("Expected New Year's Day 2022 to carry its observed date, got %v", newYear)
+	}
+}
+
+func TestObservanceRuleFollowingWeekday(t *testing.T) {
+	// Christmas Day 2021 fell on a Saturday and Boxing Day on a Sunday; both cannot be
+	// observed on Monday, so Boxing Day moves on to Tuesday
+	nz := NewCountry("NZ", CountryOptions{
+		ObservanceRule: ObservanceRule{Shift: ObservanceFollowingWeekday, AddObservedDays: true},
+	})
+
+	expected := map[string]time.Time{
+		"Christmas Day": time.Date(2021, 12, 27, 0, 0, 0, 0, time.UTC),
+		"Boxing Day":    time.Date(2021, 12, 28, 0, 0, 0, 0, time.UTC),
+	}
+	for name, observed := range expected {
+		dates := nz.HolidayDates(2021, name)
+		if len(dates) != 2 || !dates[1].Equal(observed) {
+			t.Errorf("Expected %s to be observed on %s, got %v", name, observed.Format("2006-01-02"), dates)
+		}
+	}
+}
+
+func TestObservanceRuleWithoutObservedDays(t *testing.T) {
+	nz := NewCountry("NZ")
+	monday := time.Date(2021, 12, 27, 0, 0, 0, 0, time.UTC)
+	if _, isHoliday := nz.IsHoliday(monday); isHoliday {
+		t.Fatal("Dec 27, 2021 should not be a holiday without an observance rule")
+	}
+
+	nz.SetObservanceRule(ObservanceRule{Shift: ObservanceFollowingWeekday})
+	if _, isHoliday := nz.IsHoliday(monday); isHoliday {
+		t.Error("Observed dates should not become holidays unless AddObservedDays is set")
+	}
+
+	christmas, _ := nz.IsHoliday(time.Date(2021, 12, 25, 0, 0, 0, 0, time.UTC))
+	if christmas == nil || christmas.Observed == nil || !christmas.Observed.Equal(monday) {
+		t.Errorf("Expected Christmas Day to be observed on Monday, got %v", christmas)
+	}
+
+	// Holidays on weekdays are left alone; Christmas Day 2024 fell on a Wednesday
+	weekday, isHoliday := nz.IsHoliday(time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC))
+	if !isHoliday || weekday.IsObserved {
+		t.Errorf("A weekday holiday should not be shifted, got %v", weekday)
+	}
+}
+
+func TestTodayStatus(t *testing.T) {
+	christmas := time.Date(2021, 12, 25, 0, 0, 0, 0, time.UTC)
+	observedDay := time.Date(2021, 12, 24, 15, 30, 0, 0, time.UTC)
+
+	// Observed dates carried only as metadata, and as holidays in their own right
+	for _, addObservedDays := range []bool{false, true} {
+		us := NewCountry("US", CountryOptions{
+			ObservanceRule: ObservanceRule{Shift: ObservanceNearestWeekday, AddObservedDays: addObservedDays},
+		})
+
+		holiday, isHoliday, isObservedDay := us.TodayStatus(christmas)
+		if !isHoliday || isObservedDay || holiday.Name != "Christmas Day" {
+			t.Errorf("AddObservedDays %v: expected Christmas Day on its nominal date, got %v, %v, %v",
+				addObservedDays, holiday, isHoliday, isObservedDay)
+		}
+
+		holiday, isHoliday, isObservedDay = us.TodayStatus(observedDay)
+		if !isHoliday || !isObservedDay || holiday == nil {
+			t.Fatalf("AddObservedDays %v: expected Dec 24, 2021 to be an observed day, got %v, %v, %v",
+				addObservedDays, holiday, isHoliday, isObservedDay)
+		}
+		if name := holiday.Name; name != "Christmas Day" && holiday.SubstituteFor != "Christmas Day" {
+			t.Errorf("AddObservedDays %v: expected the observed Christmas Day, got %s", addObservedDays, name)
+		}
+	}
+
+	// New Year's Day 2022 was observed in the previous year
+	us := NewCountry("US", CountryOptions{ObservanceRule: ObservanceRule{Shift: ObservanceNearestWeekday}})
+	holiday, isHoliday, isObservedDay := us.TodayStatus(time.Date(2021, 12, 31, 0, 0, 0, 0, time.UTC))
+	if !isHoliday || !isObservedDay || holiday.Name != "New Year's Day" {
+		t.Errorf("Expected New Year's Day 2022 observed on Dec 31, 2021, got %v, %v, %v", holiday, isHoliday, isObservedDay)
+	}
+
+	if holiday, isHoliday, isObservedDay := us.TodayStatus(time.Date(2021, 12, 23, 0, 0, 0, 0, time.UTC)); holiday != nil || isHoliday || isObservedDay {
+		t.Errorf("Expected Dec 23, 2021 not to be a holiday, got %v, %v, %v", holiday, isHoliday, isObservedDay)
+	}
+}
+
+func TestWithoutObservanceKeepsSettings(t *testing.T) {
+	us := NewCountry("US", CountryOptions{
+		Subdivisions:    []string{"CA"},
+		IncludeOptional: true,
+		ObservanceRule:  ObservanceRule{Shift: ObservanceNearestWeekday},
+		CheckLanguages:  true,
+		MaxCachedYears:  2,
+	})
+	us.AddRecurringHoliday("Founders' Day", NthWeekdayRule(time.Monday, 1, time.March), CategoryPublic)
+	us.HolidaysForYear(2024)
+
+	clone := us.withoutObservance()
+	if clone.observance.Shift != ObservanceNone || clone.checkLanguages {
+		t.Errorf("Expected no observance rule or warnings, got %+v", clone.countrySettings)
+	}
+	if len(clone.years) != 0 || clone.cache.maxYears != 0 {
+		t.Errorf("Expected no loaded years and no cache bound, got %d years and bound %d", len(clone.years), clone.cache.maxYears)
+	}
+
+	expected := us.countrySettings
+	expected.observance = ObservanceRule{}
+	expected.checkLanguages = false
+	if !reflect.DeepEqual(clone.countrySettings, expected) {
+		t.Errorf("Expected the other settings to be kept, got %+v", clone.countrySettings)
+	}
+}
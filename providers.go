@@ -0,0 +1,98 @@
+package goholidays
+
+import (
+	"sync"
+
+	"github.com/coredds/goholiday/countries"
+)
+
+// registeredProviders holds the provider factories added with RegisterProvider and
+// RegisterProviderFactory, by country code
+var (
+	registeredProvidersMu sync.RWMutex
+	registeredProviders   = map[string]func() countries.HolidayProvider{}
+)
+
+// RegisterProvider serves the provider's country with it, e.g. a countries.JSONProvider
+// built from a file written by the sync tool. See RegisterProviderFactory.
+func RegisterProvider(provider countries.HolidayProvider) error {
+	return RegisterProviderFactory(provider.GetCountryCode(), func() countries.HolidayProvider {
+		return provider
+	})
+}
+
+// RegisterProviderFactory serves the country code with the providers factory creates,
+// one for each year loaded. It adds a country GoHoliday does not ship, or overrides a
+// built-in one: a registered provider takes precedence over both the built-in holidays
+// and an embedded dataset. Registering a code again replaces its provider for years
+// loaded afterwards.
+func RegisterProviderFactory(code string, factory func() countries.HolidayProvider) error {
+	if code == "" {
+		return NewCountryError(ErrInvalidCountry, code, "provider has no country code")
+	}
+	if factory == nil {
+		return NewCountryError(ErrInvalidCountry, code, "provider factory is nil")
+	}
+
+	registeredProvidersMu.Lock()
+	defer registeredProvidersMu.Unlock()
+
+	registeredProviders[code] = factory
+	return nil
+}
+
+// registeredProvider returns a provider from the factory registered for code, if any
+func registeredProvider(code string) (countries.HolidayProvider, bool) {
+	registeredProvidersMu.RLock()
+	factory, exists := registeredProviders[code]
+	registeredProvidersMu.RUnlock()
+
+	if !exists {
+		return nil, false
+	}
+	provider := factory()
+	return provider, provider != nil
+}
+
+// isRegistered reports whether a provider factory is registered for code
+func isRegistered(code string) bool {
+	registeredProvidersMu.RLock()
+	defer registeredProvidersMu.RUnlock()
+
+	_, exists := registeredProviders[code]
+	return exists
+}
+
+// runtimeProvider returns the provider serving a country at runtime: the one registered
+// for code, or else the one for its embedded dataset
+func runtimeProvider(code string) (countries.HolidayProvider, bool) {
+	if provider, exists := registeredProvider(code); exists {
+		return provider, true
+	}
+	return embeddedProvider(code)
+}
+
+// loadRegisteredHolidays loads the holidays of a country served by a registered provider
+// or embedded dataset
+func (c *Country) loadRegisteredHolidays(year int) {
+	provider, exists := runtimeProvider(c.code)
+	if !exists {
+		return
+	}
+	c.loadProviderHolidays(year, provider)
+}
+
+// loadProviderHolidays loads the holidays of a runtime provider, including those of the
+// configured subdivisions when the provider supports them
+func (c *Country) loadProviderHolidays(year int, provider countries.HolidayProvider) {
+	holidays := provider.LoadHolidays(year)
+	if subdivisionProvider, ok := provider.(countries.SubdivisionHolidayProvider); ok && len(c.subdivisions) > 0 {
+		holidays = subdivisionProvider.LoadHolidaysForSubdivisions(year, c.subdivisions)
+	}
+	for _, holiday := range holidays {
+		c.addHoliday(year, fromProviderHoliday(*holiday))
+	}
+	if expected, ok := provider.(expectedCountProvider); ok {
+		c.warnIfBelowExpected(year, expected)
+	}
+}
@@ -0,0 +1,129 @@
+package goholidays
+
+import (
+	"testing"
+	"time"
+
+	"github.com/coredds/goholiday/countries"
+)
+
+func TestRegisterProvider(t *testing.T) {
+	provider := countries.NewJSONProvider(countries.CountryData{
+		CountryCode:  "ZZ",
+		Subdivisions: map[string]string{"N": "North"},
+		Holidays: map[string]countries.HolidayDefinition{
+			"founding_day":  {Name: "Founding Day", Category: "public", Calculation: "fixed", Month: 5, Day: 8},
+			"easter_monday": {Name: "Easter Monday", Category: "religious", Calculation: "easter_based", EasterOffset: 1},
+			"north_day":     {Name: "North Day", Category: "public", Calculation: "fixed", Month: 3, Day: 15, Subdivisions: []string{"N"}},
+		},
+	})
+	t.Cleanup(func() {
+		registeredProvidersMu.Lock()
+		delete(registeredProviders, "ZZ")
+		registeredProvidersMu.Unlock()
+	})
+
+	if IsValidCountry("ZZ") {
+		t.Fatal("Expected ZZ to be unsupported before registering its provider")
+	}
+	if err := RegisterProvider(provider); err != nil {
+		t.Fatalf("RegisterProvider failed: %v", err)
+	}
+
+	zz, err := NewCountryWithError("ZZ", CountryOptions{
+		Categories: []HolidayCategory{CategoryPublic, CategoryReligious},
+	})
+	if err != nil {
+		t.Fatalf("Expected a registered country to be valid, got %v", err)
+	}
+	if h, ok := zz.IsHoliday(time.Date(2024, 5, 8, 0, 0, 0, 0, time.UTC)); !ok || h.Name != "Founding Day" {
+		t.Errorf("Expected Founding Day, got %v", h)
+	}
+	if h, ok := zz.IsHoliday(time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)); !ok || h.Category != CategoryReligious {
+		t.Errorf("Expected Easter Monday as a religious holiday, got %v", h)
+	}
+
+	northDay := time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)
+	if _, ok := zz.IsHoliday(northDay); ok {
+		t.Error("Expected North Day only for subdivision N")
+	}
+	north := NewCountry("ZZ", CountryOptions{Subdivisions: []string{"N"}})
+	if h, ok := north.IsHoliday(northDay); !ok || h.Name != "North Day" {
+		t.Errorf("Expected North Day in subdivision N, got %v", h)
+	}
+
+	found := false
+	for _, code := range GetSupportedCountries() {
+		found = found || code == "ZZ"
+	}
+	if !found {
+		t.Error("Expected GetSupportedCountries to include the registered country")
+	}
+
+	if err := RegisterProvider(countries.NewJSONProvider(countries.CountryData{})); err == nil {
+		t.Error("Expected an error for a provider without a country code")
+	}
+}
+
+func TestRegisterProviderFactory(t *testing.T) {
+	t.Cleanup(func() {
+		registeredProvidersMu.Lock()
+		delete(registeredProviders, "US")
+		delete(registeredProviders, "ZX")
+		registeredProvidersMu.Unlock()
+	})
+
+	created := 0
+	factory := func() countries.HolidayProvider {
+		created++
+		return countries.NewJSONProvider(countries.CountryData{
+			CountryCode: "US",
+			Holidays: map[string]countries.HolidayDefinition{
+				"company_day": {Name: "Company Day", Category: "public", Calculation: "fixed", Month: 6, Day: 3},
+			},
+		})
+	}
+
+	// A registered provider overrides the built-in US holidays
+	if err := RegisterProviderFactory("US", factory); err != nil {
+		t.Fatalf("RegisterProviderFactory failed: %v", err)
+	}
+	if !IsValidCountry("US") || created != 0 {
+		t.Errorf("Expected US to stay valid without creating a provider, created %d", created)
+	}
+	us := NewCountry("US")
+	if _, ok := us.IsHoliday(time.Date(2024, 7, 4, 0, 0, 0, 0, time.UTC)); ok {
+		t.Error("Expected the registered provider to replace the built-in US holidays")
+	}
+	if h, ok := us.IsHoliday(time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC)); !ok || h.Name != "Company Day" {
+		t.Errorf("Expected Company Day from the registered provider, got %v", h)
+	}
+	us.HolidaysForYear(2025)
+	if created != 2 {
+		t.Errorf("Expected a provider for each year loaded, got %d", created)
+	}
+
+	// A new country is supported once registered
+	if err := RegisterProviderFactory("ZX", func() countries.HolidayProvider {
+		return countries.NewJSONProvider(countries.CountryData{CountryCode: "ZX"})
+	}); err != nil {
+		t.Fatalf("RegisterProviderFactory failed: %v", err)
+	}
+	if !IsValidCountry("ZX") {
+		t.Error("Expected ZX to be valid once registered")
+	}
+	found := false
+	for _, code := range GetSupportedCountries() {
+		found = found || code == "ZX"
+	}
+	if !found {
+		t.Error("Expected GetSupportedCountries to include ZX")
+	}
+
+	if err := RegisterProviderFactory("", factory); err == nil {
+		t.Error("Expected an error for an empty country code")
+	}
+	if err := RegisterProviderFactory("ZX", nil); err == nil {
+		t.Error("Expected an error for a nil factory")
+	}
+}
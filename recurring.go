@@ -0,0 +1,98 @@
+package goholidays
+
+import (
+	"time"
+
+	"github.com/coredds/goholiday/countries"
+)
+
+// RecurrenceKind identifies how a RecurrenceRule derives its dates
+type RecurrenceKind int
+
+const (
+	// RecurrenceNthWeekday falls on the nth weekday of each listed month
+	RecurrenceNthWeekday RecurrenceKind = iota
+	// RecurrenceEasterOffset falls a fixed number of days from Western Easter Sunday
+	RecurrenceEasterOffset
+)
+
+// RecurrenceRule describes the dates of a custom holiday that recurs every year
+type RecurrenceRule struct {
+	Kind         RecurrenceKind
+	Months       []time.Month // RecurrenceNthWeekday: months the holiday falls in
+	Weekday      time.Weekday // RecurrenceNthWeekday: day of the week
+	N            int          // RecurrenceNthWeekday: 1 for first, 2 for second, ... or -1 for last
+	EasterOffset int          // RecurrenceEasterOffset: days after (or before, if negative) Easter
+}
+
+// NthWeekdayRule returns a rule for the nth weekday of each of the given months,
+// e.g. NthWeekdayRule(time.Monday, 2, time.August) for the 2nd Monday of August or
+// NthWeekdayRule(time.Friday, -1, time.March, time.June, time.September, time.December)
+// for the last Friday of every quarter
+func NthWeekdayRule(weekday time.Weekday, n int, months ...time.Month) RecurrenceRule {
+	return RecurrenceRule{Kind: RecurrenceNthWeekday, Months: months, Weekday: weekday, N: n}
+}
+
+// EasterOffsetRule returns a rule for the day offset days from Western Easter Sunday
+func EasterOffsetRule(offset int) RecurrenceRule {
+	return RecurrenceRule{Kind: RecurrenceEasterOffset, EasterOffset: offset}
+}
+
+// Dates returns the dates the rule produces in year. Occurrences that do not exist,
+// such as a 5th Monday in a month with four, are skipped.
+func (r RecurrenceRule) Dates(year int) []time.Time {
+	var dates []time.Time
+
+	switch r.Kind {
+	case RecurrenceNthWeekday:
+		for _, month := range r.Months {
+			date := countries.NthWeekdayOfMonth(year, month, r.Weekday, r.N)
+			if !date.IsZero() && date.Month() == month {
+				dates = append(dates, date)
+			}
+		}
+	case RecurrenceEasterOffset:
+		dates = append(dates, countries.EasterSunday(year).AddDate(0, 0, r.EasterOffset))
+	}
+
+	return dates
+}
+
+// recurringHoliday is a custom holiday registered with AddRecurringHoliday
+type recurringHoliday struct {
+	name     string
+	rule     RecurrenceRule
+	category HolidayCategory
+}
+
+// AddRecurringHoliday registers a custom holiday that recurs every year according to rule.
+// The loaded years are dropped and reload with the holiday, so it goes through the same
+// steps, such as the observance rule, whether it was added before or after a year was first used.
+func (c *Country) AddRecurringHoliday(name string, rule RecurrenceRule, category HolidayCategory) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	recurring := recurringHoliday{name: name, rule: rule, category: category}
+	c.recurring = append(c.recurring, recurring)
+	c.resetYears()
+}
+
+// applyRecurringHolidays adds the registered recurring holidays to a year being loaded
+// (caller must hold the write lock)
+func (c *Country) applyRecurringHolidays(year int) {
+	for _, recurring := range c.recurring {
+		c.addRecurringHoliday(year, recurring)
+	}
+}
+
+// addRecurringHoliday adds one recurring holiday's dates for year (caller must hold the write lock)
+func (c *Country) addRecurringHoliday(year int, recurring recurringHoliday) {
+	for _, date := range recurring.rule.Dates(year) {
+		c.addHoliday(year, &Holiday{
+			Name:      recurring.name,
+			Date:      date,
+			Category:  recurring.category,
+			Languages: map[string]string{"en": recurring.name},
+		})
+	}
+}
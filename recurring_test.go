@@ -0,0 +1,97 @@
+package goholidays
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestRecurrenceRuleDates(t *testing.T) {
+	quarterEnd := NthWeekdayRule(time.Friday, -1, time.March, time.June, time.September, time.December)
+	dates := quarterEnd.Dates(2024)
+	expected := []time.Time{
+		time.Date(2024, 3, 29, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 6, 28, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 9, 27, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 12, 27, 0, 0, 0, 0, time.UTC),
+	}
+	if len(dates) != len(expected) {
+		t.Fatalf("Expected %d dates, got %d", len(expected), len(dates))
+	}
+	for i := range expected {
+		if !dates[i].Equal(expected[i]) {
+			t.Errorf("Expected %s, got %s", expected[i].Format("2006-01-02"), dates[i].Format("2006-01-02"))
+		}
+	}
+
+	// October 2024 has only four Mondays
+	if dates := NthWeekdayRule(time.Monday, 5, time.October).Dates(2024); len(dates) != 0 {
+		t.Errorf("Expected no 5th Monday in October 2024, got %v", dates)
+	}
+
+	if dates := EasterOffsetRule(-47).Dates(2024); len(dates) != 1 || !dates[0].Equal(time.Date(2024, 2, 13, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected Shrove Tuesday on 2024-02-13, got %v", dates)
+	}
+}
+
+func TestAddRecurringHoliday(t *testing.T) {
+	us := NewCountry("US")
+
+	// Load a year before registering to check already-loaded years pick it up too
+	us.HolidaysForYear(2024)
+
+	us.AddRecurringHoliday("Company Picnic", NthWeekdayRule(time.Monday, 2, time.August), CategoryPublic)
+	us.AddRecurringHoliday("Spring Retreat", EasterOffsetRule(1), CategoryPublic)
+
+	tests := []struct {
+		name string
+		date time.Time
+	}{
+		{"Company Picnic", time.Date(2024, 8, 12, 0, 0, 0, 0, time.UTC)},
+		{"Company Picnic", time.Date(2025, 8, 11, 0, 0, 0, 0, time.UTC)},
+		{"Company Picnic", time.Date(2030, 8, 12, 0, 0, 0, 0, time.UTC)},
+		{"Spring Retreat", time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{"Spring Retreat", time.Date(2025, 4, 21, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		holiday, isHoliday := us.IsHoliday(tt.date)
+		if !isHoliday || holiday.Name != tt.name {
+			t.Errorf("Expected %s on %s, got %v", tt.name, tt.date.Format("2006-01-02"), holiday)
+		}
+	}
+
+	if holiday, _ := us.IsHoliday(time.Date(2025, 8, 12, 0, 0, 0, 0, time.UTC)); holiday != nil {
+		t.Errorf("Expected no holiday on 2025-08-12, got %s", holiday.Name)
+	}
+}
+
+func TestAddRecurringHolidayLoadOrder(t *testing.T) {
+	rule := ObservanceRule{Shift: ObservanceNearestWeekday, AddObservedDays: true}
+	teamDay := NthWeekdayRule(time.Saturday, 2, time.June)
+
+	before := NewCountry("US")
+	before.SetObservanceRule(rule)
+	before.AddRecurringHoliday("Team Day", teamDay, CategoryPublic)
+
+	after := NewCountry("US")
+	after.SetObservanceRule(rule)
+	after.HolidaysForYear(2024)
+	after.AddRecurringHoliday("Team Day", teamDay, CategoryPublic)
+
+	// 2024-06-08 is a Saturday, observed on the Friday with an observed-day entry either way
+	saturday := time.Date(2024, 6, 8, 0, 0, 0, 0, time.UTC)
+	friday := time.Date(2024, 6, 7, 0, 0, 0, 0, time.UTC)
+	for name, country := range map[string]*Country{"before": before, "after": after} {
+		holiday, _ := country.IsHoliday(saturday)
+		if holiday == nil || holiday.Observed == nil || !holiday.Observed.Equal(friday) {
+			t.Errorf("Added %s loading: expected Team Day observed on %s, got %v", name, friday.Format("2006-01-02"), holiday)
+		}
+		if _, ok := country.IsHoliday(friday); !ok {
+			t.Errorf("Added %s loading: expected an observed-day entry on %s", name, friday.Format("2006-01-02"))
+		}
+	}
+	if !reflect.DeepEqual(before.HolidaysForYear(2024), after.HolidaysForYear(2024)) {
+		t.Error("Expected the same holidays whether added before or after the year loaded")
+	}
+}
@@ -0,0 +1,71 @@
+package goholidays
+
+import (
+	"strings"
+	"time"
+)
+
+// foldAccents maps accented Latin letters to their unaccented lowercase form
+var foldAccents = strings.NewReplacer(
+	"à", "a", "á", "a", "â", "a", "ã", "a", "ä", "a", "å", "a", "ā", "a", "ą", "a",
+	"ç", "c", "ć", "c", "č", "c",
+	"ď", "d", "đ", "d",
+	"è", "e", "é", "e", "ê", "e", "ë", "e", "ē", "e", "ę", "e", "ě", "e",
+	"ğ", "g",
+	"ì", "i", "í", "i", "î", "i", "ï", "i", "ī", "i", "ı", "i",
+	"ł", "l",
+	"ñ", "n", "ń", "n", "ň", "n",
+	"ò", "o", "ó", "o", "ô", "o", "õ", "o", "ö", "o", "ø", "o", "ō", "o", "ő", "o",
+	"ř", "r",
+	"ś", "s", "š", "s", "ş", "s", "ș", "s", "ß", "ss",
+	"ť", "t", "ţ", "t", "ț", "t",
+	"ù", "u", "ú", "u", "û", "u", "ü", "u", "ū", "u", "ů", "u", "ű", "u",
+	"ý", "y", "ÿ", "y",
+	"ź", "z", "ż", "z", "ž", "z",
+)
+
+// normalizeHolidayName lowercases a name, strips Latin accents and collapses whitespace
+func normalizeHolidayName(name string) string {
+	return strings.Join(strings.Fields(foldAccents.Replace(strings.ToLower(name))), " ")
+}
+
+// matchesHolidayName reports whether the holiday's name or any of its translations
+// equals the normalized query
+func matchesHolidayName(holiday *Holiday, query string) bool {
+	if normalizeHolidayName(holiday.Name) == query {
+		return true
+	}
+	for _, name := range holiday.Languages {
+		if normalizeHolidayName(name) == query {
+			return true
+		}
+	}
+	return false
+}
+
+// SearchHolidayByName returns, for every supported country with a holiday named name in
+// year, the date it falls on. Names are compared case- and accent-insensitively against
+// the holiday name and all its translations. When a country has several matching
+// holidays the earliest date is returned.
+func SearchHolidayByName(name string, year int) map[string]time.Time {
+	query := normalizeHolidayName(name)
+	results := make(map[string]time.Time)
+	if query == "" {
+		return results
+	}
+
+	for _, code := range GetSupportedCountries() {
+		country := NewCountry(code)
+	dates:
+		for _, primary := range country.SortedHolidaysForYear(year) {
+			for _, holiday := range country.HolidaysOn(primary.Date) {
+				if matchesHolidayName(holiday, query) {
+					results[code] = primary.Date
+					break dates
+				}
+			}
+		}
+	}
+
+	return results
+}
@@ -0,0 +1,47 @@
+package goholidays
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSearchHolidayByName(t *testing.T) {
+	results := SearchHolidayByName("christmas day", 2024)
+	if len(results) < 10 {
+		t.Fatalf("Expected at least 10 countries with Christmas Day, got %d: %v", len(results), results)
+	}
+
+	christmas := time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC)
+	for _, code := range []string{"US", "GB", "AU", "NZ", "CA"} {
+		date, ok := results[code]
+		if !ok {
+			t.Errorf("Expected %s to have Christmas Day", code)
+			continue
+		}
+		if !date.Equal(christmas) {
+			t.Errorf("Expected %s Christmas Day on %s, got %s", code, christmas.Format("2006-01-02"), date.Format("2006-01-02"))
+		}
+	}
+
+	if results := SearchHolidayByName("No Such Holiday", 2024); len(results) != 0 {
+		t.Errorf("Expected no matches for an unknown name, got %v", results)
+	}
+}
+
+func TestNormalizeHolidayName(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"Christmas Day", "christmas day"},
+		{"  Día de la  Independencia ", "dia de la independencia"},
+		{"Fête Nationale", "fete nationale"},
+		{"Tag der Deutschen Einheit", "tag der deutschen einheit"},
+	}
+
+	for _, test := range tests {
+		if got := normalizeHolidayName(test.input); got != test.expected {
+			t.Errorf("normalizeHolidayName(%q) = %q, expected %q", test.input, got, test.expected)
+		}
+	}
+}
@@ -0,0 +1,227 @@
+package goholidays
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+// csvLanguagePrefix starts the header of a CSV column holding holiday names in one language
+const csvLanguagePrefix = "Lang:"
+
+// LoadFromJSON creates a Country whose holidays come entirely from a JSON snapshot, such
+// as one written by ExportHolidaysJSON: an array of holidays, or the object keyed by date
+// that the goholidays CLI prints with -format json. No provider logic runs, so years
+// missing from the snapshot have no holidays. The Country has no country code.
+func LoadFromJSON(r io.Reader) (*Country, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, NewHolidayErrorWithCause(ErrDataLoadFailed, "failed to read JSON snapshot", err)
+	}
+
+	var holidays []*Holiday
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+		var byDate map[string]*Holiday
+		err = json.Unmarshal(trimmed, &byDate)
+		for _, holiday := range byDate {
+			holidays = append(holidays, holiday)
+		}
+	} else {
+		err = json.Unmarshal(trimmed, &holidays)
+	}
+	if err != nil {
+		return nil, NewHolidayErrorWithCause(ErrDataLoadFailed, "failed to parse JSON snapshot", err)
+	}
+
+	for i, holiday := range holidays {
+		if holiday == nil || holiday.Name == "" || holiday.Date.IsZero() {
+			return nil, NewHolidayError(ErrDataLoadFailed, fmt.Sprintf("JSON snapshot holiday %d has no name or date", i+1))
+		}
+	}
+	return newSnapshotCountry(holidays), nil
+}
+
+// LoadFromCSV creates a Country whose holidays come entirely from a CSV snapshot, such as
+// one written by ExportHolidaysCSV or the goholidays CLI with -format csv. The header names
+// the columns, in any order and case: Date and Name are required, while Category (public
+// when empty), Observed and one Lang:xx column per language are optional. Dates are
+// YYYY-MM-DD. No provider logic runs, so years missing from the snapshot have no
+// holidays. The Country has no country code.
+func LoadFromCSV(r io.Reader) (*Country, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	header, err := reader.Read()
+	if err != nil {
+		return nil, NewHolidayErrorWithCause(ErrDataLoadFailed, "failed to read CSV snapshot header", err)
+	}
+
+	columns := map[string]int{}
+	languages := map[int]string{}
+	for i, name := range header {
+		name = strings.TrimSpace(name)
+		if len(name) > len(csvLanguagePrefix) && strings.EqualFold(name[:len(csvLanguagePrefix)], csvLanguagePrefix) {
+			languages[i] = name[len(csvLanguagePrefix):]
+			continue
+		}
+		columns[strings.ToLower(name)] = i
+	}
+	for _, required := range []string{"date", "name"} {
+		if _, exists := columns[required]; !exists {
+			return nil, NewHolidayError(ErrDataLoadFailed, fmt.Sprintf("CSV snapshot has no %s column", required))
+		}
+	}
+
+	field := func(record []string, column string) string {
+		if i, exists := columns[column]; exists && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	var holidays []*Holiday
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, NewHolidayErrorWithCause(ErrDataLoadFailed, "failed to read CSV snapshot", err)
+		}
+
+		date, err := time.Parse("2006-01-02", field(record, "date"))
+		if err != nil {
+			return nil, NewHolidayErrorWithCause(ErrInvalidDate, fmt.Sprintf("CSV snapshot line %d has an invalid date", line), err)
+		}
+		holiday := &Holiday{
+			Name:     field(record, "name"),
+			Date:     date,
+			Category: HolidayCategory(field(record, "category")),
+		}
+		if holiday.Name == "" {
+			return nil, NewHolidayError(ErrDataLoadFailed, fmt.Sprintf("CSV snapshot line %d has no name", line))
+		}
+		if holiday.Category == "" {
+			holiday.Category = CategoryPublic
+		}
+		if value := field(record, "observed"); value != "" {
+			observed, err := time.Parse("2006-01-02", value)
+			if err != nil {
+				return nil, NewHolidayErrorWithCause(ErrInvalidDate, fmt.Sprintf("CSV snapshot line %d has an invalid observed date", line), err)
+			}
+			holiday.Observed = &observed
+			holiday.IsObserved = true
+		}
+		for i, lang := range languages {
+			if i < len(record) && strings.TrimSpace(record[i]) != "" {
+				if holiday.Languages == nil {
+					holiday.Languages = make(map[string]string)
+				}
+				holiday.Languages[lang] = strings.TrimSpace(record[i])
+			}
+		}
+		holidays = append(holidays, holiday)
+	}
+
+	return newSnapshotCountry(holidays), nil
+}
+
+// newSnapshotCountry creates a Country serving the given holidays, grouped by year
+func newSnapshotCountry(holidays []*Holiday) *Country {
+	c := NewCountry("")
+	// The snapshot holds exactly the holidays to serve
+	c.includeOptional = true
+	c.snapshot = make(map[int][]*Holiday)
+	for _, holiday := range holidays {
+		holiday.Date = time.Date(holiday.Date.Year(), holiday.Date.Month(), holiday.Date.Day(), 0, 0, 0, 0, time.UTC)
+		c.snapshot[holiday.Date.Year()] = append(c.snapshot[holiday.Date.Year()], holiday)
+	}
+
+	// Order by date, keeping the file's order for holidays sharing a date
+	for _, yearHolidays := range c.snapshot {
+		sort.SliceStable(yearHolidays, func(i, j int) bool {
+			return yearHolidays[i].Date.Before(yearHolidays[j].Date)
+		})
+	}
+	return c
+}
+
+// loadSnapshotHolidays loads a copy of the snapshot's holidays for a year (caller must
+// hold the write lock)
+func (c *Country) loadSnapshotHolidays(year int) {
+	for _, holiday := range c.snapshot[year] {
+		loaded := *holiday
+		c.addHoliday(year, &loaded)
+	}
+}
+
+// snapshotHolidays returns every holiday of the years, including those sharing a date,
+// in date order
+func (c *Country) snapshotHolidays(years []int) []*Holiday {
+	var holidays []*Holiday
+	for _, year := range years {
+		for _, holiday := range c.SortedHolidaysForYear(year) {
+			holidays = append(holidays, c.HolidaysOn(holiday.Date)...)
+		}
+	}
+	return holidays
+}
+
+// ExportHolidaysJSON writes every holiday of the years to w as a JSON array, including
+// holidays sharing a date, for LoadFromJSON to read back
+func (c *Country) ExportHolidaysJSON(w io.Writer, years ...int) error {
+	holidays := c.snapshotHolidays(years)
+	if holidays == nil {
+		holidays = []*Holiday{}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(holidays)
+}
+
+// ExportHolidaysCSV writes every holiday of the years to w as CSV, including holidays
+// sharing a date, for LoadFromCSV to read back. The columns are Date, Name, Category,
+// Observed (empty when the holiday has no observed date) and a Lang:xx column for each language
+// any holiday is named in.
+func (c *Country) ExportHolidaysCSV(w io.Writer, years ...int) error {
+	holidays := c.snapshotHolidays(years)
+
+	var languages []string
+	for _, holiday := range holidays {
+		for lang := range holiday.Languages {
+			languages = appendMissing(languages, lang)
+		}
+	}
+	sort.Strings(languages)
+
+	writer := csv.NewWriter(w)
+	header := []string{"Date", "Name", "Category", "Observed"}
+	for _, lang := range languages {
+		header = append(header, csvLanguagePrefix+lang)
+	}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	for _, holiday := range holidays {
+		observed := ""
+		if holiday.IsObserved && holiday.Observed != nil {
+			observed = holiday.Observed.Format("2006-01-02")
+		}
+		record := []string{holiday.Date.Format("2006-01-02"), holiday.Name, string(holiday.Category), observed}
+		for _, lang := range languages {
+			record = append(record, holiday.Languages[lang])
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
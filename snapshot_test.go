@@ -0,0 +1,139 @@
+package goholidays
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// assertSameHolidays checks that got serves the same holidays as want for the years
+func assertSameHolidays(t *testing.T, want, got *Country, years ...int) {
+	t.Helper()
+
+	for _, year := range years {
+		if len(got.HolidaysForYear(year)) != len(want.HolidaysForYear(year)) {
+			t.Errorf("%d: expected %d holidays, got %d", year, len(want.HolidaysForYear(year)), len(got.HolidaysForYear(year)))
+		}
+		for date := range want.HolidaysForYear(year) {
+			expected, actual := want.HolidaysOn(date), got.HolidaysOn(date)
+			if len(actual) != len(expected) {
+				t.Errorf("%s: expected %d holidays, got %d", date.Format("2006-01-02"), len(expected), len(actual))
+				continue
+			}
+			for i := range expected {
+				e, a := expected[i], actual[i]
+				if a.Name != e.Name || a.Category != e.Category || a.IsObserved != e.IsObserved ||
+					!reflect.DeepEqual(a.Observed, e.Observed) || !reflect.DeepEqual(a.Languages, e.Languages) {
+					t.Errorf("%s: expected %+v, got %+v", date.Format("2006-01-02"), e, a)
+				}
+			}
+		}
+	}
+}
+
+func TestHolidaysJSONRoundTrip(t *testing.T) {
+	// 2021 has Independence Day observed on Monday, July 5
+	us := NewCountry("US", CountryOptions{Subdivisions: []string{"MA"}})
+
+	var buf bytes.Buffer
+	if err := us.ExportHolidaysJSON(&buf, 2021, 2024); err != nil {
+		t.Fatalf("ExportHolidaysJSON failed: %v", err)
+	}
+	snapshot, err := LoadFromJSON(&buf)
+	if err != nil {
+		t.Fatalf("LoadFromJSON failed: %v", err)
+	}
+
+	assertSameHolidays(t, us, snapshot, 2021, 2024)
+	if got := snapshot.HolidaysForYear(2022); len(got) != 0 {
+		t.Errorf("Expected no holidays for a year missing from the snapshot, got %d", len(got))
+	}
+	if snapshot.GetCountryCode() != "" {
+		t.Errorf("Expected no country code, got %q", snapshot.GetCountryCode())
+	}
+
+	// The CLI prints an object keyed by date
+	cli, err := json.Marshal(us.HolidaysForYear(2024))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fromCLI, err := LoadFromJSON(bytes.NewReader(cli))
+	if err != nil {
+		t.Fatalf("LoadFromJSON failed on CLI output: %v", err)
+	}
+	assertSameHolidays(t, us, fromCLI, 2024)
+
+	for _, input := range []string{"", "not json", `[{"name": "No Date"}]`} {
+		if _, err := LoadFromJSON(strings.NewReader(input)); err == nil {
+			t.Errorf("Expected an error for %q", input)
+		}
+	}
+}
+
+func TestHolidaysCSVRoundTrip(t *testing.T) {
+	ca := NewCountry("CA", CountryOptions{Language: "fr"})
+
+	var buf bytes.Buffer
+	if err := ca.ExportHolidaysCSV(&buf, 2024, 2025); err != nil {
+		t.Fatalf("ExportHolidaysCSV failed: %v", err)
+	}
+	if header := strings.SplitN(buf.String(), "\n", 2)[0]; !strings.HasPrefix(header, "Date,Name,Category,Observed,Lang:") {
+		t.Errorf("Unexpected CSV header %q", header)
+	}
+
+	snapshot, err := LoadFromCSV(&buf)
+	if err != nil {
+		t.Fatalf("LoadFromCSV failed: %v", err)
+	}
+	assertSameHolidays(t, ca, snapshot, 2024, 2025)
+
+	// The CLI's CSV has no language columns, and columns may come in any order
+	cli := "name,date,category\n\"Founders' Day, observed\",2024-03-15,optional\nTown Fair,2024-09-02,\n"
+	fromCLI, err := LoadFromCSV(strings.NewReader(cli))
+	if err != nil {
+		t.Fatalf("LoadFromCSV failed: %v", err)
+	}
+	holiday, isHoliday := fromCLI.IsHoliday(time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC))
+	if !isHoliday || holiday.Name != "Founders' Day, observed" || holiday.Category != CategoryOptional {
+		t.Errorf("Expected the optional Founders' Day, got %v", holiday)
+	}
+	if holiday, _ := fromCLI.IsHoliday(time.Date(2024, 9, 2, 0, 0, 0, 0, time.UTC)); holiday == nil || holiday.Category != CategoryPublic {
+		t.Errorf("Expected Town Fair as a public holiday, got %v", holiday)
+	}
+
+	for _, input := range []string{
+		"",
+		"Name,Category\nNew Year,public\n",
+		"Date,Name\n01/01/2024,New Year\n",
+		"Date,Name\n2024-01-01,\n",
+		"Date,Name,Observed\n2024-01-01,New Year,soon\n",
+	} {
+		if _, err := LoadFromCSV(strings.NewReader(input)); err == nil {
+			t.Errorf("Expected an error for %q", input)
+		}
+	}
+}
+
+func TestSnapshotWithContext(t *testing.T) {
+	var buf bytes.Buffer
+	if err := NewCountry("US").ExportHolidaysJSON(&buf, 2024); err != nil {
+		t.Fatalf("ExportHolidaysJSON failed: %v", err)
+	}
+	snapshot, err := LoadFromJSON(&buf)
+	if err != nil {
+		t.Fatalf("LoadFromJSON failed: %v", err)
+	}
+
+	// The snapshot has no country code, which the context path must not reject
+	holiday, isHoliday, err := snapshot.IsHolidayWithContext(context.Background(), time.Date(2024, 7, 4, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("IsHolidayWithContext failed: %v", err)
+	}
+	if !isHoliday || holiday.Name != "Independence Day" {
+		t.Errorf("Expected Independence Day, got %v", holiday)
+	}
+}
@@ -6,10 +6,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"log"
 	"net/http"
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	goholidays "github.com/coredds/goholiday"
@@ -24,15 +26,51 @@ type GitHubSyncer struct {
 	branch      string
 	token       string // GitHub Personal Access Token
 	rateLimiter chan struct{}
+
+	// branchResolved is set once the branch has been checked against the repository's
+	// default branch, so after one successful lookup a 404 triggers no further lookups
+	branchResolved bool
+	branchMu       sync.Mutex // Protects branch and branchResolved
+
+	notifier Notifier // Set by SetNotifier: receives the result of each country SyncAll syncs
+
+	// pausedUntil holds every request back after GitHub reported the rate limit exceeded
+	pausedUntil time.Time
+	pauseMu     sync.Mutex // Protects pausedUntil
+}
+
+// maxRateLimitRetries is how many times a request refused by the rate limit is retried
+const maxRateLimitRetries = 3
+
+// Default SyncAll worker counts. Unauthenticated requests are limited to 60 an hour, so
+// more workers would only wait on the rate limiter; a token allows 5000 an hour.
+const (
+	defaultSyncConcurrency        = 2
+	defaultTokenSyncConcurrency   = 8
+	maxUnauthenticatedConcurrency = 2
+)
+
+// GitHubSyncerOption configures a GitHubSyncer when it is created
+type GitHubSyncerOption func(*GitHubSyncer)
+
+// WithHTTPClient makes the syncer send its requests through client instead of the default
+// one with a 30 second timeout, e.g. to go through a proxy, use a custom transport, or talk
+// to an httptest server. A nil client keeps the default.
+func WithHTTPClient(client *http.Client) GitHubSyncerOption {
+	return func(gs *GitHubSyncer) {
+		if client != nil {
+			gs.client = client
+		}
+	}
 }
 
 // NewGitHubSyncer creates a new GitHub API syncer
-func NewGitHubSyncer() *GitHubSyncer {
-	return NewGitHubSyncerWithToken("")
+func NewGitHubSyncer(options ...GitHubSyncerOption) *GitHubSyncer {
+	return NewGitHubSyncerWithToken("", options...)
 }
 
 // NewGitHubSyncerWithToken creates a new GitHub API syncer with optional authentication token
-func NewGitHubSyncerWithToken(token string) *GitHubSyncer {
+func NewGitHubSyncerWithToken(token string, options ...GitHubSyncerOption) *GitHubSyncer {
 	// Rate limiter: GitHub allows different limits based on authentication
 	// - Unauthenticated: 60 requests/hour
 	// - Authenticated: 5000 requests/hour
@@ -50,7 +88,7 @@ func NewGitHubSyncerWithToken(token string) *GitHubSyncer {
 		}
 	}()
 
-	return &GitHubSyncer{
+	gs := &GitHubSyncer{
 		client:      &http.Client{Timeout: 30 * time.Second},
 		baseURL:     "https://api.github.com",
 		repoOwner:   "vacanza",
@@ -59,6 +97,10 @@ func NewGitHubSyncerWithToken(token string) *GitHubSyncer {
 		token:       token,
 		rateLimiter: rateLimiter,
 	}
+	for _, option := range options {
+		option(gs)
+	}
+	return gs
 }
 
 // addAuthHeaders adds authentication headers to the request
@@ -133,21 +175,24 @@ type GitHubContent struct {
 	Encoding    string `json:"encoding"`
 }
 
+// CountryListing describes the country modules found in the upstream repository
+type CountryListing struct {
+	Countries []string // ISO codes of files that map to a known country
+	Unmapped  []string // Module filenames that could not be mapped to an ISO code
+}
+
 // FetchCountryList retrieves the list of available country modules
 func (gs *GitHubSyncer) FetchCountryList(ctx context.Context) ([]string, error) {
-	<-gs.rateLimiter // Rate limiting
-
-	url := fmt.Sprintf("%s/repos/%s/%s/contents/holidays/countries",
-		gs.baseURL, gs.repoOwner, gs.repoName)
-
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	listing, err := gs.FetchCountryListing(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return nil, err
 	}
+	return listing.Countries, nil
+}
 
-	gs.addAuthHeaders(req)
-
-	resp, err := gs.client.Do(req)
+// FetchCountryListing retrieves the country modules, including files that cannot be mapped to an ISO code
+func (gs *GitHubSyncer) FetchCountryListing(ctx context.Context) (*CountryListing, error) {
+	resp, err := gs.fetchContents(ctx, "holidays/countries")
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch country list: %w", err)
 	}
@@ -163,36 +208,30 @@ func (gs *GitHubSyncer) FetchCountryList(ctx context.Context) ([]string, error)
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	var countries []string
+	return gs.buildCountryListing(files), nil
+}
+
+// buildCountryListing splits Python module files into mapped country codes and unmapped filenames
+func (gs *GitHubSyncer) buildCountryListing(files []GitHubFile) *CountryListing {
+	listing := &CountryListing{}
 	for _, file := range files {
 		if file.Type == "file" && strings.HasSuffix(file.Name, ".py") && file.Name != "__init__.py" {
 			// Extract country code from filename (e.g., "united_states.py" -> "US")
 			countryCode := gs.extractCountryCode(file.Name)
 			if countryCode != "" {
-				countries = append(countries, countryCode)
+				listing.Countries = append(listing.Countries, countryCode)
+			} else {
+				listing.Unmapped = append(listing.Unmapped, file.Name)
 			}
 		}
 	}
-
-	return countries, nil
+	return listing
 }
 
 // FetchCountryFile retrieves the Python source file for a specific country
 func (gs *GitHubSyncer) FetchCountryFile(ctx context.Context, countryCode string) (string, error) {
-	<-gs.rateLimiter // Rate limiting
-
 	filename := gs.getCountryFilename(countryCode)
-	url := fmt.Sprintf("%s/repos/%s/%s/contents/holidays/countries/%s",
-		gs.baseURL, gs.repoOwner, gs.repoName, filename)
-
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-	if err != nil {
-		return "", fmt.Errorf("failed to create request: %w", err)
-	}
-
-	gs.addAuthHeaders(req)
-
-	resp, err := gs.client.Do(req)
+	resp, err := gs.fetchContents(ctx, "holidays/countries/"+filename)
 	if err != nil {
 		return "", fmt.Errorf("failed to fetch country file: %w", err)
 	}
@@ -221,6 +260,306 @@ func (gs *GitHubSyncer) FetchCountryFile(ctx context.Context, countryCode string
 	return decoded, nil
 }
 
+// fetchContents requests a path from the contents API on the configured branch. If the
+// branch returns 404 it is checked against the repository's default branch, and the
+// request is retried there when the two differ.
+func (gs *GitHubSyncer) fetchContents(ctx context.Context, path string) (*http.Response, error) {
+	gs.branchMu.Lock()
+	branch := gs.branch
+	gs.branchMu.Unlock()
+
+	resp, err := gs.getContents(ctx, path, branch)
+	if err != nil || resp.StatusCode != http.StatusNotFound {
+		return resp, err
+	}
+
+	resolved, err := gs.resolveBranch(ctx, branch)
+	if err != nil || resolved == branch {
+		// Keep the original 404 so the caller reports the missing path
+		return resp, nil
+	}
+	resp.Body.Close()
+
+	return gs.getContents(ctx, path, resolved)
+}
+
+// resolveBranch returns the branch to retry on after branch returned 404: the repository's
+// default branch, looked up unless a previous lookup succeeded or another request already
+// switched branches. The lock is not held during the lookup, so other requests can proceed.
+func (gs *GitHubSyncer) resolveBranch(ctx context.Context, branch string) (string, error) {
+	gs.branchMu.Lock()
+	if gs.branch != branch || gs.branchResolved {
+		current := gs.branch
+		gs.branchMu.Unlock()
+		return current, nil
+	}
+	gs.branchMu.Unlock()
+
+	defaultBranch, err := gs.fetchDefaultBranch(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	gs.branchMu.Lock()
+	defer gs.branchMu.Unlock()
+
+	if gs.branch == branch && !gs.branchResolved {
+		if defaultBranch != branch {
+			log.Printf("Branch %q not found in %s/%s, falling back to default branch %q",
+				branch, gs.repoOwner, gs.repoName, defaultBranch)
+			gs.branch = defaultBranch
+		}
+		gs.branchResolved = true
+	}
+	return gs.branch, nil
+}
+
+// getContents performs a single rate-limited contents API request on branch
+func (gs *GitHubSyncer) getContents(ctx context.Context, path, branch string) (*http.Response, error) {
+	url := fmt.Sprintf("%s/repos/%s/%s/contents/%s?ref=%s",
+		gs.baseURL, gs.repoOwner, gs.repoName, path, branch)
+	return gs.doRateLimited(ctx, url)
+}
+
+// doRateLimited sends a GET request once the rate limiter allows it. When GitHub refuses
+// the request because the rate limit is exceeded, every request is held back for the
+// time GitHub asks for and this one is retried, up to maxRateLimitRetries times.
+func (gs *GitHubSyncer) doRateLimited(ctx context.Context, url string) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		if err := gs.waitForRateLimit(ctx); err != nil {
+			return nil, err
+		}
+
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+		gs.addAuthHeaders(req)
+
+		resp, err := gs.client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		wait, limited := rateLimitWait(resp, time.Now())
+		if !limited || attempt == maxRateLimitRetries {
+			return resp, nil
+		}
+		resp.Body.Close()
+
+		log.Printf("GitHub rate limit exceeded, retrying in %s", wait)
+		gs.pauseMu.Lock()
+		if until := time.Now().Add(wait); until.After(gs.pausedUntil) {
+			gs.pausedUntil = until
+		}
+		gs.pauseMu.Unlock()
+	}
+}
+
+// waitForRateLimit blocks until a request may be sent: past any pause after the rate
+// limit was exceeded, and once the rate limiter allows it
+func (gs *GitHubSyncer) waitForRateLimit(ctx context.Context) error {
+	gs.pauseMu.Lock()
+	pause := time.Until(gs.pausedUntil)
+	gs.pauseMu.Unlock()
+
+	if pause > 0 {
+		timer := time.NewTimer(pause)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	select {
+	case <-gs.rateLimiter:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// rateLimitWait reports whether resp refused a request because the rate limit was
+// exceeded and, if so, how long to wait before retrying: the Retry-After header, in
+// seconds or as a date, or else the X-RateLimit-Reset time once no requests remain.
+// A 403 without either is a permission error and is not retried.
+func rateLimitWait(resp *http.Response, now time.Time) (time.Duration, bool) {
+	if resp.StatusCode != http.StatusForbidden && resp.StatusCode != http.StatusTooManyRequests {
+		return 0, false
+	}
+
+	wait := time.Duration(-1)
+	if retryAfter := resp.Header.Get("Retry-After"); retryAfter != "" {
+		if seconds, err := strconv.Atoi(retryAfter); err == nil {
+			wait = time.Duration(seconds) * time.Second
+		} else if date, err := http.ParseTime(retryAfter); err == nil {
+			wait = date.Sub(now)
+		}
+	} else if resp.Header.Get("X-RateLimit-Remaining") == "0" {
+		if reset, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+			wait = time.Unix(reset, 0).Sub(now)
+		}
+	}
+
+	if wait == -1 {
+		return 0, false
+	}
+	if wait < 0 {
+		wait = 0
+	}
+	return wait, true
+}
+
+// fetchDefaultBranch queries the repos API for the repository's default branch
+func (gs *GitHubSyncer) fetchDefaultBranch(ctx context.Context) (string, error) {
+	url := fmt.Sprintf("%s/repos/%s/%s", gs.baseURL, gs.repoOwner, gs.repoName)
+
+	resp, err := gs.doRateLimited(ctx, url)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch repository info: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("GitHub API error %d fetching repository info", resp.StatusCode)
+	}
+
+	var repo struct {
+		DefaultBranch string `json:"default_branch"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&repo); err != nil {
+		return "", fmt.Errorf("failed to decode repository info: %w", err)
+	}
+	if repo.DefaultBranch == "" {
+		return "", fmt.Errorf("repository info has no default branch")
+	}
+
+	return repo.DefaultBranch, nil
+}
+
+// SyncAll fetches, validates and parses every available country across a pool of
+// concurrency workers, returning the parsed data keyed by country code and one error for
+// each country that failed. Requests still share the syncer's rate limiter, and back off
+// as GitHub asks when the rate limit is exceeded. A concurrency below 1 picks a default
+// that is higher with a token; without one it is capped at 2, as the unauthenticated
+// rate limit leaves nothing for more workers to do. Once ctx is done no further
+// countries are started; those never attempted are reported by a single error.
+func (gs *GitHubSyncer) SyncAll(ctx context.Context, concurrency int) (map[string]*CountryData, []error) {
+	countries, err := gs.FetchCountryList(ctx)
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	if concurrency < 1 {
+		concurrency = defaultSyncConcurrency
+		if gs.token != "" {
+			concurrency = defaultTokenSyncConcurrency
+		}
+	}
+	if gs.token == "" && concurrency > maxUnauthenticatedConcurrency {
+		concurrency = maxUnauthenticatedConcurrency
+	}
+
+	results := make(map[string]*CountryData, len(countries))
+	failures := make([]error, len(countries)) // By country index, so errors keep list order
+	attempted := 0
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	jobs := make(chan int)
+	for worker := 0; worker < concurrency; worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				// A job can still be handed out as ctx is cancelled
+				if ctx.Err() != nil {
+					continue
+				}
+				result := SyncResult{CountryCode: countries[i], StartedAt: time.Now()}
+				data, err := gs.syncCountry(ctx, countries[i])
+				gs.notify(ctx, result, data, err)
+				mu.Lock()
+				attempted++
+				if err != nil {
+					failures[i] = fmt.Errorf("%s: %w", countries[i], err)
+				} else {
+					results[countries[i]] = data
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+dispatch:
+	for i := range countries {
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	var errs []error
+	for _, err := range failures {
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if skipped := len(countries) - attempted; skipped > 0 {
+		errs = append(errs, fmt.Errorf("%d countries not synced: %w", skipped, ctx.Err()))
+	}
+	return results, errs
+}
+
+// SetNotifier configures an optional notifier that receives the result of fetching and
+// parsing each country in SyncAll. Workers call it concurrently.
+func (gs *GitHubSyncer) SetNotifier(notifier Notifier) {
+	gs.notifier = notifier
+}
+
+// notify passes the outcome of syncing a country to the configured notifier, if any
+func (gs *GitHubSyncer) notify(ctx context.Context, result SyncResult, data *CountryData, err error) {
+	if gs.notifier == nil {
+		return
+	}
+
+	result.Duration = time.Since(result.StartedAt)
+	result.Success = err == nil
+	if err != nil {
+		result.Error = err.Error()
+	} else {
+		result.Holidays = len(data.Holidays)
+	}
+
+	if err := gs.notifier.Notify(ctx, result); err != nil {
+		log.Printf("Warning: failed to send sync notification for %s: %v", result.CountryCode, err)
+	}
+}
+
+// syncCountry fetches, validates and parses one country's source
+func (gs *GitHubSyncer) syncCountry(ctx context.Context, countryCode string) (*CountryData, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	source, err := gs.FetchCountryFile(ctx, countryCode)
+	if err != nil {
+		return nil, err
+	}
+	if err := gs.ValidatePythonContent(source); err != nil {
+		return nil, fmt.Errorf("invalid Python content: %w", err)
+	}
+	data, err := gs.ParseHolidayDefinitions(source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse definitions: %w", err)
+	}
+	return data, nil
+}
+
 // ParseHolidayDefinitions extracts holiday definitions from Python source code
 func (gs *GitHubSyncer) ParseHolidayDefinitions(pythonSource string) (*CountryData, error) {
 	countryData := &CountryData{
@@ -2,6 +2,13 @@ package updater
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -402,3 +409,312 @@ class UnitedStates(HolidayBase):
 		_, _ = syncer.ParseHolidayDefinitions(pythonSource)
 	}
 }
+
+func TestGitHubSyncer_FetchCountryListing(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/holidays/countries") {
+			t.Errorf("Unexpected request path %s", r.URL.Path)
+		}
+		files := []GitHubFile{
+			{Name: "__init__.py", Type: "file"},
+			{Name: "united_states.py", Type: "file"},
+			{Name: "germany.py", Type: "file"},
+			{Name: "atlantis.py", Type: "file"},
+			{Name: "README.md", Type: "file"},
+			{Name: "subdir", Type: "dir"},
+		}
+		_ = json.NewEncoder(w).Encode(files)
+	}))
+	defer server.Close()
+
+	syncer := NewGitHubSyncer()
+	syncer.baseURL = server.URL
+
+	listing, err := syncer.FetchCountryListing(context.Background())
+	if err != nil {
+		t.Fatalf("FetchCountryListing() failed: %v", err)
+	}
+
+	if len(listing.Countries) != 2 || !contains(listing.Countries, "US") || !contains(listing.Countries, "DE") {
+		t.Errorf("Expected US and DE, got %v", listing.Countries)
+	}
+
+	if len(listing.Unmapped) != 1 || listing.Unmapped[0] != "atlantis.py" {
+		t.Errorf("Expected atlantis.py to be unmapped, got %v", listing.Unmapped)
+	}
+
+	countries, err := syncer.FetchCountryList(context.Background())
+	if err != nil {
+		t.Fatalf("FetchCountryList() failed: %v", err)
+	}
+	if len(countries) != 2 {
+		t.Errorf("Expected FetchCountryList to return only mapped countries, got %v", countries)
+	}
+}
+
+func TestGitHubSyncer_DefaultBranchFallback(t *testing.T) {
+	source := "self._add_holiday(DEC, 25, \"Christmas Day\")\n"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/repos/vacanza/holidays":
+			_ = json.NewEncoder(w).Encode(map[string]string{"default_branch": "main"})
+		case r.URL.Query().Get("ref") != "main":
+			http.NotFound(w, r)
+		case strings.HasSuffix(r.URL.Path, "/holidays/countries"):
+			_ = json.NewEncoder(w).Encode([]GitHubFile{{Name: "united_states.py", Type: "file"}})
+		case strings.HasSuffix(r.URL.Path, "/united_states.py"):
+			_ = json.NewEncoder(w).Encode(GitHubContent{
+				Content:  base64.StdEncoding.EncodeToString([]byte(source)),
+				Encoding: "base64",
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	// A token shortens the rate limit interval for the several requests below
+	syncer := NewGitHubSyncerWithToken("test-token")
+	syncer.baseURL = server.URL
+
+	countries, err := syncer.FetchCountryList(context.Background())
+	if err != nil {
+		t.Fatalf("FetchCountryList() failed: %v", err)
+	}
+	if len(countries) != 1 || countries[0] != "US" {
+		t.Errorf("Expected [US], got %v", countries)
+	}
+	if syncer.branch != "main" {
+		t.Errorf("Expected syncer to fall back to branch 'main', got '%s'", syncer.branch)
+	}
+
+	content, err := syncer.FetchCountryFile(context.Background(), "US")
+	if err != nil {
+		t.Fatalf("FetchCountryFile() failed: %v", err)
+	}
+	if content != source {
+		t.Errorf("Expected fetched source %q, got %q", source, content)
+	}
+
+	// A genuinely missing file still reports the 404 without another fallback
+	if _, err := syncer.FetchCountryFile(context.Background(), "XX"); err == nil {
+		t.Error("Expected an error for a missing country file")
+	}
+}
+
+func TestGitHubSyncer_DefaultBranchLookupRetriedAfterError(t *testing.T) {
+	var repoFailures atomic.Int32
+	repoFailures.Store(1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/repos/vacanza/holidays":
+			if repoFailures.Add(-1) >= 0 {
+				http.Error(w, "unavailable", http.StatusServiceUnavailable)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]string{"default_branch": "main"})
+		case r.URL.Query().Get("ref") != "main":
+			http.NotFound(w, r)
+		default:
+			_ = json.NewEncoder(w).Encode([]GitHubFile{{Name: "united_states.py", Type: "file"}})
+		}
+	}))
+	defer server.Close()
+
+	syncer := NewGitHubSyncerWithToken("test-token")
+	syncer.baseURL = server.URL
+
+	// The failed lookup keeps the 404 without marking the branch as resolved
+	if _, err := syncer.FetchCountryList(context.Background()); err == nil {
+		t.Fatal("Expected an error while the default branch lookup fails")
+	}
+	if syncer.branchResolved {
+		t.Error("Expected a failed lookup to leave the branch unresolved")
+	}
+
+	countries, err := syncer.FetchCountryList(context.Background())
+	if err != nil {
+		t.Fatalf("FetchCountryList() failed after the lookup recovered: %v", err)
+	}
+	if len(countries) != 1 || syncer.branch != "main" {
+		t.Errorf("Expected [US] from branch 'main', got %v from '%s'", countries, syncer.branch)
+	}
+}
+
+// recordingTransport records each request before serving it from the wrapped transport
+type recordingTransport struct {
+	next     http.RoundTripper
+	requests []*http.Request
+}
+
+func (rt *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	rt.requests = append(rt.requests, req)
+	return rt.next.RoundTrip(req)
+}
+
+func TestGitHubSyncer_WithHTTPClient(t *testing.T) {
+	mock := NewMockHTTPTransport()
+	mock.AddResponse("https://api.github.com/user", http.StatusOK, `{"login": "octocat"}`)
+	transport := &recordingTransport{next: mock}
+	client := &http.Client{Transport: transport}
+
+	syncer := NewGitHubSyncerWithToken("test-token", WithHTTPClient(client))
+	if syncer.client != client {
+		t.Fatal("Expected the syncer to use the supplied client")
+	}
+
+	if err := syncer.ValidateToken(context.Background()); err != nil {
+		t.Fatalf("ValidateToken() failed: %v", err)
+	}
+	if len(transport.requests) != 1 {
+		t.Fatalf("Expected 1 recorded request, got %d", len(transport.requests))
+	}
+	req := transport.requests[0]
+	if req.URL.String() != "https://api.github.com/user" {
+		t.Errorf("Expected request to /user, got %s", req.URL)
+	}
+	if auth := req.Header.Get("Authorization"); auth != "Bearer test-token" {
+		t.Errorf("Expected bearer token header, got %q", auth)
+	}
+
+	// A nil client keeps the default
+	if syncer := NewGitHubSyncer(WithHTTPClient(nil)); syncer.client == nil || syncer.client.Timeout != 30*time.Second {
+		t.Error("Expected the default client with a 30 second timeout")
+	}
+}
+
+func TestGitHubSyncer_SyncAll(t *testing.T) {
+	source := "class UnitedStates(HolidayBase):\n    self._add_holiday(DEC, 25, \"Christmas Day\")\n"
+	var limited atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/holidays/countries"):
+			_ = json.NewEncoder(w).Encode([]GitHubFile{
+				{Name: "united_states.py", Type: "file"},
+				{Name: "germany.py", Type: "file"},
+				{Name: "france.py", Type: "file"},
+			})
+		case strings.HasSuffix(r.URL.Path, "/germany.py") && !limited.Swap(true):
+			// Refuse the first request for Germany as if the rate limit were exceeded
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusForbidden)
+		case strings.HasSuffix(r.URL.Path, "/france.py"):
+			_ = json.NewEncoder(w).Encode(GitHubContent{
+				Content:  base64.StdEncoding.EncodeToString([]byte("# no holidays here")),
+				Encoding: "base64",
+			})
+		case strings.HasSuffix(r.URL.Path, ".py"):
+			_ = json.NewEncoder(w).Encode(GitHubContent{
+				Content:  base64.StdEncoding.EncodeToString([]byte(source)),
+				Encoding: "base64",
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	syncer := NewGitHubSyncerWithToken("test-token")
+	syncer.baseURL = server.URL
+	notifier := &recordingNotifier{}
+	syncer.SetNotifier(notifier)
+
+	results, errs := syncer.SyncAll(context.Background(), 3)
+	if len(results) != 2 || results["US"] == nil || results["DE"] == nil {
+		t.Errorf("Expected US and DE to sync, got %v", results)
+	}
+	if !limited.Load() {
+		t.Error("Expected the rate limited request to be made")
+	}
+	if len(errs) != 1 || !strings.HasPrefix(errs[0].Error(), "FR: ") {
+		t.Errorf("Expected one error for FR, got %v", errs)
+	}
+	notified := map[string]bool{}
+	for _, result := range notifier.Results() {
+		notified[result.CountryCode] = result.Success
+	}
+	if len(notified) != 3 || !notified["US"] || !notified["DE"] || notified["FR"] {
+		t.Errorf("Expected US and DE notified as successes and FR as a failure, got %v", notified)
+	}
+
+	// A failed country listing is the only error
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusInternalServerError)
+	}))
+	defer failing.Close()
+	syncer = NewGitHubSyncerWithToken("test-token")
+	syncer.baseURL = failing.URL
+	if results, errs := syncer.SyncAll(context.Background(), 0); len(results) != 0 || len(errs) != 1 {
+		t.Errorf("Expected a single listing error, got %v and %v", results, errs)
+	}
+}
+
+func TestGitHubSyncer_SyncAllStopsOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var fetched atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/holidays/countries") {
+			_ = json.NewEncoder(w).Encode([]GitHubFile{
+				{Name: "united_states.py", Type: "file"},
+				{Name: "germany.py", Type: "file"},
+				{Name: "france.py", Type: "file"},
+			})
+			return
+		}
+		// Cancel while the first country is being fetched
+		if strings.HasSuffix(r.URL.Path, ".py") {
+			fetched.Add(1)
+			cancel()
+		}
+		http.NotFound(w, r)
+	}))
+	defer server.Close()
+
+	syncer := NewGitHubSyncerWithToken("test-token")
+	syncer.baseURL = server.URL
+	notifier := &recordingNotifier{}
+	syncer.SetNotifier(notifier)
+
+	_, errs := syncer.SyncAll(ctx, 1)
+	if fetched.Load() != 1 {
+		t.Errorf("Expected no country to be started after cancellation, got %d fetched", fetched.Load())
+	}
+	if len(notifier.Results()) != 1 {
+		t.Errorf("Expected only the attempted country to be notified, got %v", notifier.Results())
+	}
+	if len(errs) == 0 || !errors.Is(errs[len(errs)-1], context.Canceled) ||
+		!strings.HasPrefix(errs[len(errs)-1].Error(), "2 countries not synced") {
+		t.Errorf("Expected a single error for the 2 countries not attempted, got %v", errs)
+	}
+}
+
+func TestRateLimitWait(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		status  int
+		headers map[string]string
+		wait    time.Duration
+		limited bool
+	}{
+		{"OK", http.StatusOK, nil, 0, false},
+		{"Retry-After seconds", http.StatusForbidden, map[string]string{"Retry-After": "30"}, 30 * time.Second, true},
+		{"Retry-After date", http.StatusTooManyRequests, map[string]string{"Retry-After": now.Add(time.Minute).Format(http.TimeFormat)}, time.Minute, true},
+		{"Reset time", http.StatusForbidden, map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": "1704110520"}, 2 * time.Minute, true},
+		{"Reset passed", http.StatusForbidden, map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": "1704110000"}, 0, true},
+		{"Permission error", http.StatusForbidden, map[string]string{"X-RateLimit-Remaining": "42"}, 0, false},
+	}
+
+	for _, tt := range tests {
+		resp := &http.Response{StatusCode: tt.status, Header: http.Header{}}
+		for key, value := range tt.headers {
+			resp.Header.Set(key, value)
+		}
+		wait, limited := rateLimitWait(resp, now)
+		if wait != tt.wait || limited != tt.limited {
+			t.Errorf("%s: expected (%s, %v), got (%s, %v)", tt.name, tt.wait, tt.limited, wait, limited)
+		}
+	}
+}
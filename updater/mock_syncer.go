@@ -10,9 +10,11 @@ import (
 // MockSyncer is a mock implementation of the Syncer interface for testing
 type MockSyncer struct {
 	countries    []string
+	unmapped     []string
 	countryFiles map[string]string
 	shouldError  bool
 	errorMessage string
+	hasUpdates   bool
 }
 
 // NewMockSyncer creates a new mock syncer with default test data
@@ -34,6 +36,19 @@ func (m *MockSyncer) SetError(shouldError bool, message string) {
 	m.errorMessage = message
 }
 
+// SetUpdatesAvailable configures the result reported by CheckForUpdates
+func (m *MockSyncer) SetUpdatesAvailable(hasUpdates bool) {
+	m.hasUpdates = hasUpdates
+}
+
+// CheckForUpdates reports the configured update availability
+func (m *MockSyncer) CheckForUpdates() (bool, error) {
+	if m.shouldError {
+		return false, fmt.Errorf("mock error: %s", m.errorMessage)
+	}
+	return m.hasUpdates, nil
+}
+
 // AddCountry adds a country to the mock data
 func (m *MockSyncer) AddCountry(code, source string) {
 	// Add to countries list if not already present
@@ -69,6 +84,24 @@ func (m *MockSyncer) FetchCountryList(ctx context.Context) ([]string, error) {
 	return append([]string{}, m.countries...), nil
 }
 
+// AddUnmappedFile adds a module filename that cannot be mapped to a country code
+func (m *MockSyncer) AddUnmappedFile(filename string) {
+	m.unmapped = append(m.unmapped, filename)
+}
+
+// FetchCountryListing returns the mock countries and unmapped filenames
+func (m *MockSyncer) FetchCountryListing(ctx context.Context) (*CountryListing, error) {
+	countries, err := m.FetchCountryList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CountryListing{
+		Countries: countries,
+		Unmapped:  append([]string{}, m.unmapped...),
+	}, nil
+}
+
 // FetchCountryFile returns mock Python source for a country
 func (m *MockSyncer) FetchCountryFile(ctx context.Context, countryCode string) (string, error) {
 	if m.shouldError {
@@ -0,0 +1,91 @@
+package updater
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+)
+
+// SyncResult describes the outcome of syncing a single country
+type SyncResult struct {
+	CountryCode string        `json:"country_code"`
+	Success     bool          `json:"success"`
+	Error       string        `json:"error,omitempty"`
+	Holidays    int           `json:"holidays"`
+	StartedAt   time.Time     `json:"started_at"`
+	Duration    time.Duration `json:"duration"`
+}
+
+// Notifier receives sync results, e.g. to alert operators about failures
+type Notifier interface {
+	Notify(ctx context.Context, result SyncResult) error
+}
+
+// StdoutNotifier writes a one-line summary of each sync result
+type StdoutNotifier struct {
+	out io.Writer
+}
+
+// NewStdoutNotifier creates a notifier that writes to standard output
+func NewStdoutNotifier() *StdoutNotifier {
+	return &StdoutNotifier{out: os.Stdout}
+}
+
+// Notify writes the sync result summary
+func (n *StdoutNotifier) Notify(ctx context.Context, result SyncResult) error {
+	if result.Success {
+		_, err := fmt.Fprintf(n.out, "[sync] %s: success (%d holidays) in %v\n",
+			result.CountryCode, result.Holidays, result.Duration)
+		return err
+	}
+
+	_, err := fmt.Fprintf(n.out, "[sync] %s: failed after %v: %s\n",
+		result.CountryCode, result.Duration, result.Error)
+	return err
+}
+
+// WebhookNotifier POSTs each sync result as JSON to a webhook URL
+type WebhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookNotifier creates a notifier that posts results to the given URL
+func NewWebhookNotifier(url string) *WebhookNotifier {
+	return &WebhookNotifier{
+		url:    url,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Notify posts the sync result to the webhook
+func (n *WebhookNotifier) Notify(ctx context.Context, result SyncResult) error {
+	body, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("failed to encode sync result: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", n.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("webhook returned status %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package updater
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// recordingNotifier collects the results it receives
+type recordingNotifier struct {
+	mu      sync.Mutex
+	results []SyncResult
+}
+
+func (n *recordingNotifier) Notify(ctx context.Context, result SyncResult) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.results = append(n.results, result)
+	return nil
+}
+
+// Results returns a copy of the results received so far
+func (n *recordingNotifier) Results() []SyncResult {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return append([]SyncResult(nil), n.results...)
+}
+
+func TestPythonHolidaysSync_Notifier(t *testing.T) {
+	sync := NewMockPythonHolidaysSync(t.TempDir())
+	notifier := &recordingNotifier{}
+	sync.SetNotifier(notifier)
+
+	ctx := context.Background()
+
+	if err := sync.SyncCountry(ctx, "US"); err != nil {
+		t.Fatalf("SyncCountry() failed: %v", err)
+	}
+	if err := sync.SyncCountry(ctx, "XX"); err == nil {
+		t.Fatal("Expected error for invalid country code")
+	}
+
+	if len(notifier.results) != 2 {
+		t.Fatalf("Expected 2 notifications, got %d", len(notifier.results))
+	}
+
+	success := notifier.results[0]
+	if !success.Success || success.CountryCode != "US" || success.Holidays == 0 {
+		t.Errorf("Unexpected success result: %+v", success)
+	}
+
+	failure := notifier.results[1]
+	if failure.Success || failure.CountryCode != "XX" || failure.Error == "" {
+		t.Errorf("Unexpected failure result: %+v", failure)
+	}
+}
+
+func TestStdoutNotifier(t *testing.T) {
+	var buf bytes.Buffer
+	notifier := &StdoutNotifier{out: &buf}
+
+	_ = notifier.Notify(context.Background(), SyncResult{CountryCode: "US", Success: true, Holidays: 11})
+	_ = notifier.Notify(context.Background(), SyncResult{CountryCode: "XX", Error: "no provider"})
+
+	output := buf.String()
+	if !strings.Contains(output, "US: success (11 holidays)") {
+		t.Errorf("Expected success line, got %q", output)
+	}
+	if !strings.Contains(output, "XX: failed") || !strings.Contains(output, "no provider") {
+		t.Errorf("Expected failure line, got %q", output)
+	}
+}
+
+func TestWebhookNotifier(t *testing.T) {
+	var received SyncResult
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("Expected POST, got %s", r.Method)
+		}
+		if ct := r.Header.Get("Content-Type"); ct != "application/json" {
+			t.Errorf("Expected JSON content type, got %s", ct)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Errorf("Failed to decode webhook body: %v", err)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	notifier := NewWebhookNotifier(server.URL)
+	result := SyncResult{CountryCode: "GB", Success: true, Holidays: 8}
+
+	if err := notifier.Notify(context.Background(), result); err != nil {
+		t.Fatalf("Notify() failed: %v", err)
+	}
+
+	if received.CountryCode != "GB" || !received.Success || received.Holidays != 8 {
+		t.Errorf("Webhook received unexpected result: %+v", received)
+	}
+}
+
+func TestWebhookNotifier_ErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "boom", http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	notifier := NewWebhookNotifier(server.URL)
+	if err := notifier.Notify(context.Background(), SyncResult{CountryCode: "US"}); err == nil {
+		t.Error("Expected error for non-2xx webhook response")
+	}
+}
@@ -0,0 +1,129 @@
+package updater
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/coredds/goholiday/countries"
+)
+
+// ChangeType describes how a holiday differs between the live provider and parsed data
+type ChangeType string
+
+const (
+	ChangeAdded   ChangeType = "added"
+	ChangeRemoved ChangeType = "removed"
+	ChangeChanged ChangeType = "changed"
+)
+
+// HolidayChange is a single difference a sync would apply to a provider
+type HolidayChange struct {
+	Type     ChangeType `json:"type"`
+	Name     string     `json:"name"`
+	OldDate  time.Time  `json:"old_date,omitempty"`
+	NewDate  time.Time  `json:"new_date,omitempty"`
+	Category string     `json:"category,omitempty"`
+	Details  string     `json:"details,omitempty"`
+}
+
+// String formats the change for display
+func (hc HolidayChange) String() string {
+	switch hc.Type {
+	case ChangeAdded:
+		return fmt.Sprintf("+ %s (%s)", hc.Name, hc.NewDate.Format("2006-01-02"))
+	case ChangeRemoved:
+		return fmt.Sprintf("- %s (%s)", hc.Name, hc.OldDate.Format("2006-01-02"))
+	default:
+		return fmt.Sprintf("~ %s: %s", hc.Name, hc.Details)
+	}
+}
+
+// DiffProvider compares the holidays a provider generates for year against
+// the holidays described by parsed data. Holidays are matched by name.
+func DiffProvider(provider countries.HolidayProvider, data *CountryData, year int) []HolidayChange {
+	current := make(map[string]*countries.Holiday)
+	for _, holiday := range provider.LoadHolidays(year) {
+		current[strings.ToLower(holiday.Name)] = holiday
+	}
+
+	fresh := make(map[string]HolidayDefinition)
+	freshDates := make(map[string]time.Time)
+	for _, definition := range data.Holidays {
+		date, ok := definition.DateForYear(year)
+		if !ok {
+			continue
+		}
+		key := strings.ToLower(definition.Name)
+		fresh[key] = definition
+		freshDates[key] = date
+	}
+
+	var changes []HolidayChange
+
+	for key, holiday := range current {
+		definition, exists := fresh[key]
+		if !exists {
+			changes = append(changes, HolidayChange{
+				Type:     ChangeRemoved,
+				Name:     holiday.Name,
+				OldDate:  holiday.Date,
+				Category: holiday.Category,
+			})
+			continue
+		}
+
+		var details []string
+		newDate := freshDates[key]
+		if !sameDay(holiday.Date, newDate) {
+			details = append(details, fmt.Sprintf("date %s -> %s", holiday.Date.Format("2006-01-02"), newDate.Format("2006-01-02")))
+		}
+		if definition.Category != "" && definition.Category != holiday.Category {
+			details = append(details, fmt.Sprintf("category %s -> %s", holiday.Category, definition.Category))
+		}
+		if len(details) > 0 {
+			changes = append(changes, HolidayChange{
+				Type:     ChangeChanged,
+				Name:     holiday.Name,
+				OldDate:  holiday.Date,
+				NewDate:  newDate,
+				Category: definition.Category,
+				Details:  strings.Join(details, ", "),
+			})
+		}
+	}
+
+	for key, definition := range fresh {
+		if _, exists := current[key]; !exists {
+			changes = append(changes, HolidayChange{
+				Type:     ChangeAdded,
+				Name:     definition.Name,
+				NewDate:  freshDates[key],
+				Category: definition.Category,
+			})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Type != changes[j].Type {
+			return changes[i].Type < changes[j].Type
+		}
+		return changes[i].Name < changes[j].Name
+	})
+
+	return changes
+}
+
+// PreviewChanges diffs parsed data against the compiled provider for its country
+func (phs *PythonHolidaysSync) PreviewChanges(data *CountryData, year int) ([]HolidayChange, error) {
+	provider, err := phs.getCountryProvider(strings.ToUpper(data.CountryCode))
+	if err != nil {
+		return nil, err
+	}
+	return DiffProvider(provider, data, year), nil
+}
+
+func sameDay(a, b time.Time) bool {
+	return a.Year() == b.Year() && a.YearDay() == b.YearDay()
+}
@@ -0,0 +1,143 @@
+package updater
+
+import (
+	"testing"
+	"time"
+
+	"github.com/coredds/goholiday/countries"
+)
+
+func TestHolidayDefinition_DateForYear(t *testing.T) {
+	tests := []struct {
+		name       string
+		definition HolidayDefinition
+		year       int
+		expected   time.Time
+		ok         bool
+	}{
+		{
+			name:       "Fixed",
+			definition: HolidayDefinition{Calculation: "fixed", Month: 7, Day: 4},
+			year:       2024,
+			expected:   time.Date(2024, 7, 4, 0, 0, 0, 0, time.UTC),
+			ok:         true,
+		},
+		{
+			name:       "Easter Based",
+			definition: HolidayDefinition{Calculation: "easter_based", EasterOffset: -2},
+			year:       2024,
+			expected:   time.Date(2024, 3, 29, 0, 0, 0, 0, time.UTC),
+			ok:         true,
+		},
+		{
+			name: "Weekday Based",
+			definition: HolidayDefinition{
+				Calculation: "weekday_based",
+				WeekdayRule: &WeekdayRule{Month: 5, Weekday: time.Monday, Occurrence: -1},
+			},
+			year:     2024,
+			expected: time.Date(2024, 5, 27, 0, 0, 0, 0, time.UTC),
+			ok:       true,
+		},
+		{
+			name:       "Outside Year Range",
+			definition: HolidayDefinition{Calculation: "fixed", Month: 6, Day: 19, YearRange: &YearRange{Start: 2021}},
+			year:       2020,
+			ok:         false,
+		},
+		{
+			name:       "Complex",
+			definition: HolidayDefinition{Calculation: "complex"},
+			year:       2024,
+			ok:         false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			date, ok := tt.definition.DateForYear(tt.year)
+			if ok != tt.ok {
+				t.Fatalf("DateForYear() ok = %v, want %v", ok, tt.ok)
+			}
+			if ok && !date.Equal(tt.expected) {
+				t.Errorf("DateForYear() = %v, want %v", date, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDiffProvider(t *testing.T) {
+	provider := countries.NewUSProvider()
+	year := 2024
+
+	// Build parsed data that mirrors the provider exactly
+	data := &CountryData{CountryCode: "US", Holidays: make(map[string]HolidayDefinition)}
+	for _, holiday := range provider.LoadHolidays(year) {
+		data.Holidays[holiday.Name] = HolidayDefinition{
+			Name:        holiday.Name,
+			Category:    holiday.Category,
+			Calculation: "fixed",
+			Month:       int(holiday.Date.Month()),
+			Day:         holiday.Date.Day(),
+		}
+	}
+
+	if changes := DiffProvider(provider, data, year); len(changes) != 0 {
+		t.Fatalf("Expected no changes for identical data, got %v", changes)
+	}
+
+	// Make the parsed data slightly different
+	delete(data.Holidays, "Juneteenth")
+	independence := data.Holidays["Independence Day"]
+	independence.Day = 5
+	data.Holidays["Independence Day"] = independence
+	data.Holidays["Test Day"] = HolidayDefinition{
+		Name:        "Test Day",
+		Category:    "federal",
+		Calculation: "fixed",
+		Month:       3,
+		Day:         14,
+	}
+
+	changes := DiffProvider(provider, data, year)
+	if len(changes) != 3 {
+		t.Fatalf("Expected 3 changes, got %d: %v", len(changes), changes)
+	}
+
+	byName := make(map[string]HolidayChange)
+	for _, change := range changes {
+		byName[change.Name] = change
+	}
+
+	if change := byName["Test Day"]; change.Type != ChangeAdded {
+		t.Errorf("Expected Test Day to be added, got %+v", change)
+	}
+	if change := byName["Juneteenth"]; change.Type != ChangeRemoved {
+		t.Errorf("Expected Juneteenth to be removed, got %+v", change)
+	}
+	change := byName["Independence Day"]
+	if change.Type != ChangeChanged {
+		t.Errorf("Expected Independence Day to be changed, got %+v", change)
+	}
+	if !change.NewDate.Equal(time.Date(year, 7, 5, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected new date July 5, got %v", change.NewDate)
+	}
+}
+
+func TestPythonHolidaysSync_PreviewChanges(t *testing.T) {
+	sync := NewMockPythonHolidaysSync(t.TempDir())
+
+	if _, err := sync.PreviewChanges(&CountryData{CountryCode: "XX"}, 2024); err == nil {
+		t.Error("Expected error for country without provider")
+	}
+
+	changes, err := sync.PreviewChanges(&CountryData{CountryCode: "us"}, 2024)
+	if err != nil {
+		t.Fatalf("PreviewChanges() failed: %v", err)
+	}
+	for _, change := range changes {
+		if change.Type != ChangeRemoved {
+			t.Errorf("Expected only removals for empty data, got %+v", change)
+		}
+	}
+}
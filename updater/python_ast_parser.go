@@ -1,6 +1,7 @@
 package updater
 
 import (
+	"encoding/json"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -215,23 +216,23 @@ func (p *PythonASTParser) tokenizeLine(line string, lineNum int) error {
 	return nil
 }
 
+// tokenPatterns match the token types recognised by tokenizeContent, in priority order
+var tokenPatterns = []struct {
+	regex     *regexp.Regexp
+	tokenType TokenType
+}{
+	{regexp.MustCompile(`^class\b`), TokenClass},
+	{regexp.MustCompile(`^def\b`), TokenDef},
+	{regexp.MustCompile(`^self\b`), TokenSelf},
+	{regexp.MustCompile(`^"([^"\\\\]|\\\\.)*"`), TokenString},
+	{regexp.MustCompile(`^'([^'\\]|\\.)*'`), TokenString},
+	{regexp.MustCompile(`^\d+`), TokenNumber},
+	{regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*`), TokenIdentifier},
+	{regexp.MustCompile(`^[+\-*/=(),.:]`), TokenOperator},
+}
+
 // tokenizeContent tokenizes the content of a line
 func (p *PythonASTParser) tokenizeContent(content string, lineNum, startColumn int) {
-	// Patterns for different token types
-	patterns := []struct {
-		regex     *regexp.Regexp
-		tokenType TokenType
-	}{
-		{regexp.MustCompile(`^class\b`), TokenClass},
-		{regexp.MustCompile(`^def\b`), TokenDef},
-		{regexp.MustCompile(`^self\b`), TokenSelf},
-		{regexp.MustCompile(`^"([^"\\\\]|\\\\.)*"`), TokenString},
-		{regexp.MustCompile(`^'([^'\\]|\\.)*'`), TokenString},
-		{regexp.MustCompile(`^\d+`), TokenNumber},
-		{regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*`), TokenIdentifier},
-		{regexp.MustCompile(`^[+\-*/=(),.:]`), TokenOperator},
-	}
-
 	pos := 0
 	for pos < len(content) {
 		// Skip whitespace
@@ -243,7 +244,7 @@ func (p *PythonASTParser) tokenizeContent(content string, lineNum, startColumn i
 		}
 
 		matched := false
-		for _, pattern := range patterns {
+		for _, pattern := range tokenPatterns {
 			if match := pattern.regex.FindString(content[pos:]); match != "" {
 				p.tokens = append(p.tokens, Token{
 					Type:   pattern.tokenType,
@@ -384,43 +385,282 @@ func (p *PythonASTParser) parseMethod(startPos int) (*MethodInfo, int, error) {
 	}, pos, nil
 }
 
+// holidayCallPatterns match the holiday method calls extracted from the source
+var holidayCallPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`self\._add_holiday\s*\(`),
+	regexp.MustCompile(`self\._add_new_years_day\s*\(`),
+	regexp.MustCompile(`self\._add_christmas_day\s*\(`),
+	regexp.MustCompile(`self\._add_easter_based_holiday\s*\(`),
+	regexp.MustCompile(`self\._add_weekday_holiday\s*\(`),
+}
+
 // extractHolidayCalls extracts holiday definition calls from the parsed AST
 func (p *PythonASTParser) extractHolidayCalls() ([]HolidayCall, error) {
 	var holidayCalls []HolidayCall
 
-	// Look for holiday method calls in the source
-	holidayPatterns := []*regexp.Regexp{
-		regexp.MustCompile(`self\._add_holiday\s*\(`),
-		regexp.MustCompile(`self\._add_new_years_day\s*\(`),
-		regexp.MustCompile(`self\._add_christmas_day\s*\(`),
-		regexp.MustCompile(`self\._add_easter_based_holiday\s*\(`),
-		regexp.MustCompile(`self\._add_weekday_holiday\s*\(`),
-	}
-
 	lines := strings.Split(p.source, "\n")
+	conditions := enclosingConditions(lines)
 
 	for lineNum, line := range lines {
-		for _, pattern := range holidayPatterns {
+		for _, pattern := range holidayCallPatterns {
 			if pattern.MatchString(line) {
-				holidayCall, err := p.parseHolidayCall(line, lineNum+1)
+				holidayCall, err := p.parseHolidayCall(joinCallLines(lines, lineNum), lineNum+1)
 				if err != nil {
 					// Log error but continue parsing
 					continue
 				}
 				if holidayCall != nil {
+					holidayCall.Conditional = conditions[lineNum]
 					holidayCalls = append(holidayCalls, *holidayCall)
 				}
 			}
 		}
 	}
 
+	holidayCalls = append(holidayCalls, p.extractSpecialHolidays()...)
+
 	return holidayCalls, nil
 }
 
+// maxCallLines bounds how many lines joinCallLines reads, so an unbalanced
+// parenthesis cannot pull the rest of the file into every call
+const maxCallLines = 20
+
+// joinCallLines returns the line at index start joined with the lines that
+// follow it until its parentheses balance, so calls split across lines parse as one
+func joinCallLines(lines []string, start int) string {
+	joined := lines[start]
+	depth := strings.Count(joined, "(") - strings.Count(joined, ")")
+	for i := start + 1; depth > 0 && i < len(lines) && i < start+maxCallLines; i++ {
+		joined += " " + strings.TrimSpace(lines[i])
+		depth += strings.Count(lines[i], "(") - strings.Count(lines[i], ")")
+	}
+	return joined
+}
+
+// ifConditionPattern matches an if statement and captures its condition
+var ifConditionPattern = regexp.MustCompile(`^if\s+(.+?)\s*:\s*(#.*)?$`)
+
+// enclosingConditions returns, for every line, the conditions of the if
+// statements enclosing it within its def, outermost first and joined with "and"
+func enclosingConditions(lines []string) []string {
+	type block struct {
+		indent     int
+		conditions string // Conditions in force inside the block
+	}
+
+	conditions := make([]string, len(lines))
+	var stack []block
+
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+
+		indent := indentation(line)
+		for len(stack) > 0 && stack[len(stack)-1].indent >= indent {
+			stack = stack[:len(stack)-1]
+		}
+		if len(stack) > 0 {
+			conditions[i] = stack[len(stack)-1].conditions
+		}
+
+		if !strings.HasSuffix(trimmed, ":") && !ifConditionPattern.MatchString(trimmed) {
+			continue
+		}
+		opened := block{indent: indent, conditions: conditions[i]}
+		if strings.HasPrefix(trimmed, "def ") || strings.HasPrefix(trimmed, "class ") {
+			// Conditions do not carry into a new scope
+			opened.conditions = ""
+		} else if match := ifConditionPattern.FindStringSubmatch(trimmed); len(match) >= 2 {
+			if opened.conditions != "" {
+				opened.conditions += " and "
+			}
+			opened.conditions += match[1]
+		}
+		stack = append(stack, opened)
+	}
+
+	return conditions
+}
+
+// indentation returns the number of leading spaces in line
+func indentation(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " "))
+}
+
+// yearBoundPattern matches comparisons of year against a literal, in either order
+var yearBoundPattern = regexp.MustCompile(`\byear\s*(>=|<=|==|>|<)\s*(\d{4})\b|\b(\d{4})\s*(>=|<=|==|>|<)\s*year\b`)
+
+// chainedYearPattern matches chained comparisons such as 1990 <= year < 2010
+var chainedYearPattern = regexp.MustCompile(`(\d{4})\s*(<=|<)\s*year\s*(<=|<)\s*(\d{4})`)
+
+// conditionYearRange derives the years a holiday applies to from a conditional
+// such as "year >= 1983" or "1971 <= year <= 2000". It returns nil when the
+// conditional does not bound the year.
+func conditionYearRange(conditional string) *YearRange {
+	// Disjunctions can describe disjoint ranges, which a YearRange cannot hold
+	if strings.Contains(conditional, " or ") {
+		return nil
+	}
+
+	var yearRange YearRange
+
+	conditional = chainedYearPattern.ReplaceAllString(conditional, "$1 $2 year and year $3 $4")
+	for _, match := range yearBoundPattern.FindAllStringSubmatch(conditional, -1) {
+		op, value := match[1], match[2]
+		if op == "" {
+			// Literal on the left: flip the comparison so it reads year <op> value
+			value = match[3]
+			op = map[string]string{">=": "<=", "<=": ">=", ">": "<", "<": ">", "==": "=="}[match[4]]
+		}
+
+		year, _ := strconv.Atoi(value)
+		switch op {
+		case ">=":
+			yearRange.Start = year
+		case ">":
+			yearRange.Start = year + 1
+		case "<=":
+			yearRange.End = year
+		case "<":
+			yearRange.End = year - 1
+		case "==":
+			yearRange.Start, yearRange.End = year, year
+		}
+	}
+
+	if yearRange.Start == 0 && yearRange.End == 0 {
+		return nil
+	}
+	return &yearRange
+}
+
+// specialTablePattern matches the start of a year-keyed special holiday table
+// such as special_public_holidays = { or special_bank_holidays: dict = {
+var specialTablePattern = regexp.MustCompile(`(?m)^\s*(special_[a-z_]*holidays[a-z_]*)\s*(?::[^=]*)?=\s*\{`)
+
+// specialYearPattern matches a year key inside a special holiday table
+var specialYearPattern = regexp.MustCompile(`(\d{4})\s*:`)
+
+// specialEntryPattern matches a (MONTH, day, "Name") tuple, optionally wrapping the name in tr()
+var specialEntryPattern = regexp.MustCompile(`\(\s*([A-Z]{3}|\d{1,2})\s*,\s*(\d{1,2})\s*,\s*(?:[a-z_]+\s*\(\s*)?(?:"([^"]*)"|'([^']*)')`)
+
+// extractSpecialHolidays extracts one-off holidays from year-keyed tables such as
+// special_public_holidays. Each entry is returned as a fixed date whose Year is the
+// table key, so it only applies to that year.
+func (p *PythonASTParser) extractSpecialHolidays() []HolidayCall {
+	var holidayCalls []HolidayCall
+
+	tables := specialTablePattern.FindAllStringSubmatchIndex(p.source, -1)
+	if len(tables) == 0 {
+		return nil
+	}
+	closing := matchingBraces(p.source)
+
+	for _, table := range tables {
+		tableName := p.source[table[2]:table[3]]
+		bodyStart := table[1]
+		bodyEnd, closed := closing[bodyStart-1]
+		if !closed {
+			continue
+		}
+		body := p.source[bodyStart:bodyEnd]
+
+		years := specialYearPattern.FindAllStringSubmatchIndex(body, -1)
+		for i, yearMatch := range years {
+			year := body[yearMatch[2]:yearMatch[3]]
+			end := len(body)
+			if i+1 < len(years) {
+				end = years[i+1][0]
+			}
+
+			section := body[yearMatch[1]:end]
+			for _, entry := range specialEntryPattern.FindAllStringSubmatchIndex(section, -1) {
+				name := submatch(section, entry, 3)
+				if name == "" {
+					name = submatch(section, entry, 4)
+				}
+				if name == "" {
+					continue
+				}
+
+				day, _ := strconv.Atoi(submatch(section, entry, 2))
+				offset := bodyStart + yearMatch[1] + entry[0]
+
+				holidayCalls = append(holidayCalls, HolidayCall{
+					Method:   tableName,
+					Name:     name,
+					Category: specialTableCategory(tableName),
+					Date: &DateExpression{
+						Type:  DateFixed,
+						Year:  year,
+						Month: submatch(section, entry, 1),
+						Day:   day,
+					},
+					Line: strings.Count(p.source[:offset], "\n") + 1,
+				})
+			}
+		}
+	}
+
+	return holidayCalls
+}
+
+// specialTableCategory derives a category from a table name, e.g. special_bank_holidays -> bank
+func specialTableCategory(tableName string) string {
+	category := strings.TrimPrefix(tableName, "special_")
+	category = strings.TrimSuffix(category, "_observed")
+	category = strings.TrimSuffix(category, "holidays")
+	category = strings.TrimSuffix(category, "_")
+	if category == "" {
+		return "public"
+	}
+	return category
+}
+
+// matchingBraces maps the index of every balanced opening brace in source to
+// the index of the brace that closes it
+func matchingBraces(source string) map[int]int {
+	closing := make(map[int]int)
+	var open []int
+	for i := 0; i < len(source); i++ {
+		switch source[i] {
+		case '{':
+			open = append(open, i)
+		case '}':
+			if len(open) > 0 {
+				closing[open[len(open)-1]] = i
+				open = open[:len(open)-1]
+			}
+		}
+	}
+	return closing
+}
+
+// submatch returns the text of capture group n from a FindStringSubmatchIndex result
+func submatch(s string, match []int, n int) string {
+	if 2*n+1 >= len(match) || match[2*n] < 0 {
+		return ""
+	}
+	return s[match[2*n]:match[2*n+1]]
+}
+
+// Patterns used when parsing individual holiday calls
+var (
+	methodPattern           = regexp.MustCompile(`self\.(_add_[a-z_]+)\s*\(`)
+	doubleQuotePattern      = regexp.MustCompile(`"([^"]*)"`)
+	singleQuotePattern      = regexp.MustCompile(`'([^']*)'`)
+	easterCallOffsetPattern = regexp.MustCompile(`[+-]?\d+`)
+	fixedDatePattern        = regexp.MustCompile(`date\s*\(\s*year\s*,\s*([A-Z]+|\d+)\s*,\s*(\d+)\s*\)`)
+	easterPattern           = regexp.MustCompile(`easter\s*\(\s*year\s*\)(\s*[+-]\s*rd\s*\(\s*days\s*=\s*(\d+)\s*\))?`)
+	easterOffsetPattern     = regexp.MustCompile(`days\s*=\s*([+-]?\d+)`)
+)
+
 // parseHolidayCall parses a single holiday call from a line
 func (p *PythonASTParser) parseHolidayCall(line string, lineNum int) (*HolidayCall, error) {
 	// Extract method name
-	methodPattern := regexp.MustCompile(`self\.(_add_[a-z_]+)\s*\(`)
 	methodMatch := methodPattern.FindStringSubmatch(line)
 	if len(methodMatch) < 2 {
 		return nil, fmt.Errorf("could not extract method name")
@@ -450,12 +690,10 @@ func (p *PythonASTParser) parseAddHolidayCall(line string, lineNum int, methodNa
 	var holidayName string
 
 	// Try double quotes first
-	doubleQuotePattern := regexp.MustCompile(`"([^"]*)"`)
 	if matches := doubleQuotePattern.FindStringSubmatch(line); len(matches) >= 2 {
 		holidayName = matches[1]
 	} else {
 		// Try single quotes
-		singleQuotePattern := regexp.MustCompile(`'([^']*)'`)
 		if matches := singleQuotePattern.FindStringSubmatch(line); len(matches) >= 2 {
 			holidayName = matches[1]
 		} else {
@@ -484,12 +722,10 @@ func (p *PythonASTParser) parseEasterBasedCall(line string, lineNum int, methodN
 	var holidayName string
 
 	// Try double quotes first
-	doubleQuotePattern := regexp.MustCompile(`"([^"]*)"`)
 	if matches := doubleQuotePattern.FindStringSubmatch(line); len(matches) >= 2 {
 		holidayName = matches[1]
 	} else {
 		// Try single quotes
-		singleQuotePattern := regexp.MustCompile(`'([^']*)'`)
 		if matches := singleQuotePattern.FindStringSubmatch(line); len(matches) >= 2 {
 			holidayName = matches[1]
 		} else {
@@ -498,8 +734,7 @@ func (p *PythonASTParser) parseEasterBasedCall(line string, lineNum int, methodN
 	}
 
 	// Extract days offset
-	offsetPattern := regexp.MustCompile(`[+-]?\d+`)
-	offsetMatch := offsetPattern.FindString(line)
+	offsetMatch := easterCallOffsetPattern.FindString(line)
 
 	calculation := "easter(year)"
 	if offsetMatch != "" {
@@ -531,12 +766,10 @@ func (p *PythonASTParser) parseWeekdayCall(line string, lineNum int, methodName
 	var holidayName string
 
 	// Try double quotes first
-	doubleQuotePattern := regexp.MustCompile(`"([^"]*)"`)
 	if matches := doubleQuotePattern.FindStringSubmatch(line); len(matches) >= 2 {
 		holidayName = matches[1]
 	} else {
 		// Try single quotes
-		singleQuotePattern := regexp.MustCompile(`'([^']*)'`)
 		if matches := singleQuotePattern.FindStringSubmatch(line); len(matches) >= 2 {
 			holidayName = matches[1]
 		} else {
@@ -560,12 +793,10 @@ func (p *PythonASTParser) parseGenericHolidayCall(line string, lineNum int, meth
 	var holidayName string
 
 	// Try double quotes first
-	doubleQuotePattern := regexp.MustCompile(`"([^"]*)"`)
 	if matches := doubleQuotePattern.FindStringSubmatch(line); len(matches) >= 2 {
 		holidayName = matches[1]
 	} else {
 		// Try single quotes
-		singleQuotePattern := regexp.MustCompile(`'([^']*)'`)
 		if matches := singleQuotePattern.FindStringSubmatch(line); len(matches) >= 2 {
 			holidayName = matches[1]
 		} else {
@@ -589,7 +820,6 @@ func (p *PythonASTParser) extractDateExpression(line string) (*DateExpression, e
 	// Look for common date patterns
 
 	// Fixed date: date(year, MONTH, day)
-	fixedDatePattern := regexp.MustCompile(`date\s*\(\s*year\s*,\s*([A-Z]+|\d+)\s*,\s*(\d+)\s*\)`)
 	if match := fixedDatePattern.FindStringSubmatch(line); len(match) >= 3 {
 		month := match[1]
 		day, _ := strconv.Atoi(match[2])
@@ -603,7 +833,6 @@ func (p *PythonASTParser) extractDateExpression(line string) (*DateExpression, e
 	}
 
 	// Easter-based: easter(year) + rd(days=N)
-	easterPattern := regexp.MustCompile(`easter\s*\(\s*year\s*\)(\s*[+-]\s*rd\s*\(\s*days\s*=\s*(\d+)\s*\))?`)
 	if match := easterPattern.FindStringSubmatch(line); len(match) >= 1 {
 		calculation := "easter(year)"
 		if len(match) >= 3 && match[2] != "" {
@@ -631,6 +860,7 @@ func (p *PythonASTParser) extractDateExpression(line string) (*DateExpression, e
 // ConvertToHolidayDefinitions converts parsed holiday calls to HolidayDefinition format
 func (p *PythonASTParser) ConvertToHolidayDefinitions(holidayCalls []HolidayCall) map[string]HolidayDefinition {
 	definitions := make(map[string]HolidayDefinition)
+	keys := newDefinitionKeys()
 
 	for _, call := range holidayCalls {
 		key := strings.ToLower(strings.ReplaceAll(call.Name, " ", "_"))
@@ -640,6 +870,11 @@ func (p *PythonASTParser) ConvertToHolidayDefinitions(holidayCalls []HolidayCall
 			Category:  "public", // Default category
 			Languages: map[string]string{"en": call.Name},
 		}
+		if call.Category != "" {
+			definition.Category = call.Category
+		}
+
+		definition.YearRange = conditionYearRange(call.Conditional)
 
 		// Convert date expression to definition fields
 		if call.Date != nil {
@@ -654,6 +889,10 @@ func (p *PythonASTParser) ConvertToHolidayDefinitions(holidayCalls []HolidayCall
 				if day, ok := call.Date.Day.(int); ok {
 					definition.Day = day
 				}
+				// A literal year pins a one-off holiday to that year
+				if year, err := strconv.Atoi(call.Date.Year); err == nil {
+					definition.YearRange = &YearRange{Start: year, End: year}
+				}
 
 			case DateEasterBased:
 				definition.Calculation = "easter_based"
@@ -668,12 +907,56 @@ func (p *PythonASTParser) ConvertToHolidayDefinitions(holidayCalls []HolidayCall
 			}
 		}
 
-		definitions[key] = definition
+		definitions[keys.assign(definitions, key, definition)] = definition
 	}
 
 	return definitions
 }
 
+// definitionKeys assigns distinct map keys to converted definitions whose
+// names collide. The first holiday keeps the plain key and later ones get
+// key_2, key_3, ... Calls are converted in source order, so the suffixes are
+// stable between runs. A repeated identical definition reuses its key.
+type definitionKeys struct {
+	assigned map[string]string // Key plus definition fingerprint -> assigned key
+	next     map[string]int    // Key -> next suffix to try
+}
+
+func newDefinitionKeys() *definitionKeys {
+	return &definitionKeys{
+		assigned: make(map[string]string),
+		next:     make(map[string]int),
+	}
+}
+
+// assign returns the key to store definition under
+func (dk *definitionKeys) assign(definitions map[string]HolidayDefinition, key string, definition HolidayDefinition) string {
+	fingerprint, _ := json.Marshal(definition)
+	id := key + "\x00" + string(fingerprint)
+	if assigned, exists := dk.assigned[id]; exists {
+		return assigned
+	}
+
+	candidate := key
+	if _, taken := definitions[candidate]; taken {
+		n := dk.next[key]
+		if n < 2 {
+			n = 2
+		}
+		for {
+			candidate = fmt.Sprintf("%s_%d", key, n)
+			n++
+			if _, taken := definitions[candidate]; !taken {
+				break
+			}
+		}
+		dk.next[key] = n
+	}
+
+	dk.assigned[id] = candidate
+	return candidate
+}
+
 // convertMonthName converts Python month constants to integers
 func (p *PythonASTParser) convertMonthName(monthName string) int {
 	monthMap := map[string]int{
@@ -696,8 +979,7 @@ func (p *PythonASTParser) convertMonthName(monthName string) int {
 
 // extractEasterOffset extracts the day offset from easter calculations
 func (p *PythonASTParser) extractEasterOffset(calculation string) int {
-	offsetPattern := regexp.MustCompile(`days\s*=\s*([+-]?\d+)`)
-	if match := offsetPattern.FindStringSubmatch(calculation); len(match) >= 2 {
+	if match := easterOffsetPattern.FindStringSubmatch(calculation); len(match) >= 2 {
 		offset, _ := strconv.Atoi(match[1])
 		// Check if it's a subtraction in the calculation
 		if strings.Contains(calculation, "- timedelta") {
@@ -482,6 +482,177 @@ class UnitedStates(HolidayBase):
 	}
 }
 
+func TestPythonASTParser_ConditionalHolidays(t *testing.T) {
+	source := `
+class UnitedStates(HolidayBase):
+    def _populate(self, year):
+        self._add_holiday("New Year's Day", date(year, JAN, 1))
+
+        # Martin Luther King Jr. Day
+        if year >= 1983:
+            self._add_holiday(
+                "Martin Luther King Jr. Day",
+                date(year, JAN, 1) + rd(weekday=MO(3))
+            )
+
+        if year > 1970:
+            if year <= 2000:
+                self._add_holiday("Old Holiday", date(year, MAR, 3))
+
+        if 1990 <= year < 2010:
+            self._add_holiday("Ranged Holiday", date(year, APR, 4))
+
+        if year < 1950 or year > 2000:
+            self._add_holiday("Split Holiday", date(year, MAY, 5))
+`
+
+	parser := NewPythonASTParser(source)
+	holidayCalls, err := parser.Parse()
+	if err != nil {
+		t.Fatalf("Parse() failed: %v", err)
+	}
+
+	conditionals := make(map[string]string)
+	for _, call := range holidayCalls {
+		conditionals[call.Name] = call.Conditional
+	}
+
+	if got := conditionals["Martin Luther King Jr. Day"]; got != "year >= 1983" {
+		t.Errorf("Expected MLK Day conditional 'year >= 1983', got '%s'", got)
+	}
+	if got := conditionals["Old Holiday"]; got != "year > 1970 and year <= 2000" {
+		t.Errorf("Expected nested conditionals to be joined, got '%s'", got)
+	}
+	if got := conditionals["New Year's Day"]; got != "" {
+		t.Errorf("Expected no conditional for New Year's Day, got '%s'", got)
+	}
+
+	definitions := parser.ConvertToHolidayDefinitions(holidayCalls)
+
+	expected := map[string]*YearRange{
+		"martin_luther_king_jr._day": {Start: 1983},
+		"old_holiday":                {Start: 1971, End: 2000},
+		"ranged_holiday":             {Start: 1990, End: 2009},
+		"split_holiday":              nil,
+		"new_year's_day":             nil,
+	}
+
+	for key, want := range expected {
+		def, exists := definitions[key]
+		if !exists {
+			t.Errorf("Expected holiday '%s' not found", key)
+			continue
+		}
+		if want == nil {
+			if def.YearRange != nil {
+				t.Errorf("Holiday '%s': expected no year range, got %+v", key, def.YearRange)
+			}
+			continue
+		}
+		if def.YearRange == nil || *def.YearRange != *want {
+			t.Errorf("Holiday '%s': expected year range %+v, got %+v", key, want, def.YearRange)
+		}
+	}
+}
+
+func TestPythonASTParser_NameCollisions(t *testing.T) {
+	source := `
+class UnitedKingdom(HolidayBase):
+    def _populate(self, year):
+        self._add_holiday("Bank Holiday", date(year, MAY, 6))
+        self._add_holiday("Bank Holiday", date(year, AUG, 26))
+        self._add_holiday("Bank Holiday", date(year, MAY, 6))
+        self._add_holiday("Christmas Day", date(year, DEC, 25))
+`
+
+	parser := NewPythonASTParser(source)
+	holidayCalls, err := parser.Parse()
+	if err != nil {
+		t.Fatalf("Parse() failed: %v", err)
+	}
+
+	definitions := parser.ConvertToHolidayDefinitions(holidayCalls)
+
+	if len(definitions) != 3 {
+		t.Errorf("Expected 3 definitions, got %d: %v", len(definitions), definitions)
+	}
+	if def := definitions["bank_holiday"]; def.Month != 5 || def.Day != 6 {
+		t.Errorf("Expected bank_holiday on 5-6, got %d-%d", def.Month, def.Day)
+	}
+	if def, exists := definitions["bank_holiday_2"]; !exists || def.Month != 8 || def.Day != 26 {
+		t.Errorf("Expected bank_holiday_2 on 8-26, got %+v", def)
+	}
+	if _, exists := definitions["bank_holiday_3"]; exists {
+		t.Error("Expected the repeated identical definition not to get its own key")
+	}
+}
+
+func TestPythonASTParser_SpecialHolidays(t *testing.T) {
+	source := `
+class UnitedKingdom(HolidayBase):
+    special_public_holidays = {
+        2011: (APR, 29, tr("Wedding of William and Catherine")),
+        2022: (
+            (JUN, 3, tr("Platinum Jubilee of Elizabeth II")),
+            (SEP, 19, "State Funeral of Queen Elizabeth II"),
+        ),
+    }
+
+    special_bank_holidays = {1999: (DEC, 31, "Millennium Celebrations")}
+
+    def _populate(self, year):
+        self._add_holiday("New Year's Day", date(year, JAN, 1))
+`
+
+	parser := NewPythonASTParser(source)
+	holidayCalls, err := parser.Parse()
+	if err != nil {
+		t.Fatalf("Parse() failed: %v", err)
+	}
+
+	if len(holidayCalls) != 5 {
+		t.Fatalf("Expected 5 holiday calls, got %d", len(holidayCalls))
+	}
+
+	definitions := parser.ConvertToHolidayDefinitions(holidayCalls)
+
+	expected := map[string]struct {
+		month, day, year int
+		category         string
+	}{
+		"wedding_of_william_and_catherine":    {4, 29, 2011, "public"},
+		"platinum_jubilee_of_elizabeth_ii":    {6, 3, 2022, "public"},
+		"state_funeral_of_queen_elizabeth_ii": {9, 19, 2022, "public"},
+		"millennium_celebrations":             {12, 31, 1999, "bank"},
+	}
+
+	for key, want := range expected {
+		def, exists := definitions[key]
+		if !exists {
+			t.Errorf("Expected special holiday '%s' not found", key)
+			continue
+		}
+		if def.Calculation != "fixed" || def.Month != want.month || def.Day != want.day {
+			t.Errorf("Holiday '%s': expected fixed %d-%d, got %s %d-%d", key, want.month, want.day, def.Calculation, def.Month, def.Day)
+		}
+		if def.YearRange == nil || def.YearRange.Start != want.year || def.YearRange.End != want.year {
+			t.Errorf("Holiday '%s': expected year range %d-%d, got %+v", key, want.year, want.year, def.YearRange)
+		}
+		if def.Category != want.category {
+			t.Errorf("Holiday '%s': expected category '%s', got '%s'", key, want.category, def.Category)
+		}
+	}
+
+	// Recurring holidays are not pinned to a year
+	if def := definitions["new_year's_day"]; def.YearRange != nil {
+		t.Errorf("Expected no year range for New Year's Day, got %+v", def.YearRange)
+	}
+
+	if date, ok := definitions["platinum_jubilee_of_elizabeth_ii"].DateForYear(2023); ok {
+		t.Errorf("Expected the jubilee not to apply in 2023, got %s", date.Format("2006-01-02"))
+	}
+}
+
 func TestPythonASTParser_PerformanceComparison(t *testing.T) {
 	// Create a large Python source for performance testing
 	var builder strings.Builder
@@ -563,3 +734,101 @@ func BenchmarkPythonASTParser_ConvertToDefinitions(b *testing.B) {
 		_ = parser.ConvertToHolidayDefinitions(holidayCalls)
 	}
 }
+
+func TestPythonASTParser_PathologicalInputs(t *testing.T) {
+	var deep strings.Builder
+	for i := 0; i < 1000; i++ {
+		deep.WriteString(strings.Repeat(" ", i) + "if year >= 1999:\n")
+	}
+	deep.WriteString(strings.Repeat(" ", 1000) + "self._add_holiday(\"Deep\", date(year, JAN, 1))\n")
+
+	var sameName strings.Builder
+	for i := 0; i < 5000; i++ {
+		fmt.Fprintf(&sameName, "        self._add_holiday(\"Bank Holiday\", date(year, JAN, %d))\n", i)
+	}
+
+	sources := map[string]string{
+		"unbalanced parentheses": strings.Repeat("    self._add_holiday(\"Open\", (\n", 5000),
+		"unclosed tables":        strings.Repeat("special_public_holidays = {\n", 5000),
+		"deep nesting":           deep.String(),
+		"colliding names":        sameName.String(),
+		"long line":              strings.Repeat(`self._add_holiday("x", date(year, 1, 1)) `, 10000),
+	}
+
+	for name, source := range sources {
+		start := time.Now()
+		parser := NewPythonASTParser(source)
+		holidayCalls, err := parser.Parse()
+		if err != nil {
+			t.Errorf("%s: Parse() failed: %v", name, err)
+			continue
+		}
+		parser.ConvertToHolidayDefinitions(holidayCalls)
+
+		if elapsed := time.Since(start); elapsed > 5*time.Second {
+			t.Errorf("%s: parsing %d bytes took %v", name, len(source), elapsed)
+		}
+	}
+}
+
+// fuzzSeedSources are Python samples used to seed the parser fuzz tests
+var fuzzSeedSources = []string{
+	"",
+	`class TestCountry(HolidayBase):
+    def _populate(self, year):
+        self._add_holiday("New Year's Day", date(year, JAN, 1))
+        self._add_holiday("Good Friday", easter(year) - rd(days=2))
+`,
+	`def _populate(self, year):
+        if year >= 1983:
+            self._add_holiday(
+                "Martin Luther King Jr. Day",
+                date(year, JAN, 1) + rd(weekday=MO(3))
+            )
+`,
+	`special_public_holidays = {
+    2022: ((JUN, 3, tr("Platinum Jubilee")), (SEP, 19, "State Funeral")),
+}`,
+	`self._add_holiday("Unterminated, date(year, 1, 1)`,
+	`self._add_holiday('Single', date(year, 12, 25))`,
+	`special_bank_holidays = {{{ 1999: (DEC, 31, "x"`,
+}
+
+func FuzzTokenize(f *testing.F) {
+	for _, source := range fuzzSeedSources {
+		f.Add(source)
+	}
+
+	f.Fuzz(func(t *testing.T, source string) {
+		parser := NewPythonASTParser(source)
+		if err := parser.tokenize(); err != nil {
+			return
+		}
+		for _, token := range parser.tokens {
+			if token.Line < 1 {
+				t.Fatalf("token %q has invalid line %d", token.Value, token.Line)
+			}
+		}
+	})
+}
+
+func FuzzParse(f *testing.F) {
+	for _, source := range fuzzSeedSources {
+		f.Add(source)
+	}
+
+	f.Fuzz(func(t *testing.T, source string) {
+		start := time.Now()
+
+		parser := NewPythonASTParser(source)
+		holidayCalls, err := parser.Parse()
+		if err != nil {
+			return
+		}
+		parser.ConvertToHolidayDefinitions(holidayCalls)
+
+		if elapsed := time.Since(start); elapsed > 2*time.Second {
+			t.Fatalf("parsing %d bytes took %v", len(source), elapsed)
+		}
+	})
+}
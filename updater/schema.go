@@ -0,0 +1,166 @@
+package updater
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// schemaTypes are the synced data types described by TypeScriptDefinitions and
+// JSONSchema, in output order. CountryData is the root of every synced file.
+var schemaTypes = []reflect.Type{
+	reflect.TypeOf(CountryData{}),
+	reflect.TypeOf(HolidayDefinition{}),
+	reflect.TypeOf(WeekdayRule{}),
+	reflect.TypeOf(YearRange{}),
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// TypeScriptDefinitions returns TypeScript interfaces matching the JSON written for CountryData
+func TypeScriptDefinitions() string {
+	var b strings.Builder
+
+	b.WriteString("// Code generated by goholidays sync -emit-types. DO NOT EDIT.\n")
+	for _, t := range schemaTypes {
+		fmt.Fprintf(&b, "\nexport interface %s {\n", t.Name())
+		for i := 0; i < t.NumField(); i++ {
+			name, optional, ok := jsonField(t.Field(i))
+			if !ok {
+				continue
+			}
+			marker := ""
+			if optional {
+				marker = "?"
+			}
+			fmt.Fprintf(&b, "  %s%s: %s;\n", name, marker, tsType(t.Field(i).Type))
+		}
+		b.WriteString("}\n")
+	}
+
+	return b.String()
+}
+
+// JSONSchema returns a JSON Schema (draft 2020-12) describing the JSON written for CountryData
+func JSONSchema() ([]byte, error) {
+	defs := make(map[string]interface{}, len(schemaTypes))
+	for _, t := range schemaTypes {
+		properties := make(map[string]interface{})
+		required := []string{}
+		for i := 0; i < t.NumField(); i++ {
+			name, optional, ok := jsonField(t.Field(i))
+			if !ok {
+				continue
+			}
+			properties[name] = schemaFor(t.Field(i).Type, optional)
+			if !optional {
+				required = append(required, name)
+			}
+		}
+		defs[t.Name()] = map[string]interface{}{
+			"type":                 "object",
+			"properties":           properties,
+			"required":             required,
+			"additionalProperties": false,
+		}
+	}
+
+	return json.MarshalIndent(map[string]interface{}{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"title":   "CountryData",
+		"$ref":    "#/$defs/CountryData",
+		"$defs":   defs,
+	}, "", "  ")
+}
+
+// jsonField returns the JSON name of a struct field and whether it may be omitted
+func jsonField(field reflect.StructField) (name string, optional bool, ok bool) {
+	if !field.IsExported() {
+		return "", false, false
+	}
+
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", false, false
+	}
+
+	parts := strings.Split(tag, ",")
+	name = parts[0]
+	if name == "" {
+		name = field.Name
+	}
+	for _, option := range parts[1:] {
+		if option == "omitempty" {
+			optional = true
+		}
+	}
+	return name, optional, true
+}
+
+// tsType maps a Go type to the TypeScript type of its JSON encoding
+func tsType(t reflect.Type) string {
+	if t == timeType {
+		return "string"
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		return tsType(t.Elem())
+	case reflect.Struct:
+		return t.Name()
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Slice:
+		return tsType(t.Elem()) + "[]"
+	case reflect.Map:
+		return fmt.Sprintf("Record<string, %s>", tsType(t.Elem()))
+	default:
+		return "unknown"
+	}
+}
+
+// schemaFor maps a Go type to the JSON Schema of its encoding. Slices and maps that
+// are always written may be null, since encoding/json writes nil ones as null.
+func schemaFor(t reflect.Type, optional bool) map[string]interface{} {
+	if t == timeType {
+		return map[string]interface{}{"type": "string", "format": "date-time"}
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		return schemaFor(t.Elem(), optional)
+	case reflect.Struct:
+		return map[string]interface{}{"$ref": "#/$defs/" + t.Name()}
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.Slice:
+		return map[string]interface{}{"type": nullable("array", optional), "items": schemaFor(t.Elem(), false)}
+	case reflect.Map:
+		return map[string]interface{}{"type": nullable("object", optional), "additionalProperties": schemaFor(t.Elem(), false)}
+	default:
+		return map[string]interface{}{}
+	}
+}
+
+// nullable returns the schema type for a value that is null when nil unless omitted
+func nullable(jsonType string, optional bool) interface{} {
+	if optional {
+		return jsonType
+	}
+	return []string{jsonType, "null"}
+}
@@ -0,0 +1,190 @@
+package updater
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+// validateSchema checks value against the subset of JSON Schema that JSONSchema emits
+func validateSchema(root, schema map[string]interface{}, value interface{}, path string) error {
+	if ref, ok := schema["$ref"].(string); ok {
+		name := strings.TrimPrefix(ref, "#/$defs/")
+		def, ok := root["$defs"].(map[string]interface{})[name].(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%s: unresolved $ref %s", path, ref)
+		}
+		return validateSchema(root, def, value, path)
+	}
+
+	if types, ok := schema["type"]; ok && !matchesType(types, value) {
+		return fmt.Errorf("%s: %v does not match type %v", path, value, types)
+	}
+	if schema["format"] == "date-time" {
+		if _, err := time.Parse(time.RFC3339, value.(string)); err != nil {
+			return fmt.Errorf("%s: %v is not a date-time", path, value)
+		}
+	}
+
+	switch v := value.(type) {
+	case []interface{}:
+		items, _ := schema["items"].(map[string]interface{})
+		for i, item := range v {
+			if err := validateSchema(root, items, item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	case map[string]interface{}:
+		properties, _ := schema["properties"].(map[string]interface{})
+		if required, ok := schema["required"].([]interface{}); ok {
+			for _, name := range required {
+				if _, exists := v[name.(string)]; !exists {
+					return fmt.Errorf("%s: missing required property %s", path, name)
+				}
+			}
+		}
+		for key, item := range v {
+			propertySchema, known := properties[key].(map[string]interface{})
+			if !known {
+				additional, ok := schema["additionalProperties"].(map[string]interface{})
+				if !ok {
+					if schema["additionalProperties"] == false {
+						return fmt.Errorf("%s: unexpected property %s", path, key)
+					}
+					continue
+				}
+				propertySchema = additional
+			}
+			if err := validateSchema(root, propertySchema, item, path+"."+key); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// matchesType reports whether value has one of the JSON Schema types in types
+func matchesType(types interface{}, value interface{}) bool {
+	var names []string
+	switch t := types.(type) {
+	case string:
+		names = []string{t}
+	case []interface{}:
+		for _, name := range t {
+			names = append(names, name.(string))
+		}
+	}
+
+	for _, name := range names {
+		switch v := value.(type) {
+		case nil:
+			if name == "null" {
+				return true
+			}
+		case string:
+			if name == "string" {
+				return true
+			}
+		case bool:
+			if name == "boolean" {
+				return true
+			}
+		case float64:
+			if name == "number" || (name == "integer" && v == math.Trunc(v)) {
+				return true
+			}
+		case []interface{}:
+			if name == "array" {
+				return true
+			}
+		case map[string]interface{}:
+			if name == "object" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func TestJSONSchemaValidatesCountryData(t *testing.T) {
+	raw, err := JSONSchema()
+	if err != nil {
+		t.Fatalf("JSONSchema() failed: %v", err)
+	}
+	var schema map[string]interface{}
+	if err := json.Unmarshal(raw, &schema); err != nil {
+		t.Fatalf("JSONSchema() returned invalid JSON: %v", err)
+	}
+
+	sample := CountryData{
+		CountryCode:  "US",
+		Name:         "United States",
+		Subdivisions: map[string]string{"CA": "California"},
+		Categories:   []string{"public"},
+		Languages:    []string{"en"},
+		Holidays: map[string]HolidayDefinition{
+			"independence_day": {
+				Name:        "Independence Day",
+				Category:    "public",
+				Languages:   map[string]string{"en": "Independence Day"},
+				Calculation: "fixed",
+				Month:       7,
+				Day:         4,
+			},
+			"thanksgiving": {
+				Name:        "Thanksgiving",
+				Category:    "public",
+				Languages:   map[string]string{"en": "Thanksgiving"},
+				Calculation: "weekday_based",
+				WeekdayRule: &WeekdayRule{Month: 11, Weekday: time.Thursday, Occurrence: 4},
+				YearRange:   &YearRange{Start: 1942},
+			},
+		},
+		UpdatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+
+	encoded, err := json.Marshal(sample)
+	if err != nil {
+		t.Fatalf("Failed to marshal sample: %v", err)
+	}
+	var document interface{}
+	if err := json.Unmarshal(encoded, &document); err != nil {
+		t.Fatalf("Failed to decode sample: %v", err)
+	}
+
+	if err := validateSchema(schema, schema, document, "$"); err != nil {
+		t.Errorf("Sample CountryData does not validate: %v", err)
+	}
+
+	// A document missing a required property or with a wrong type is rejected
+	invalid := map[string]interface{}{"country_code": "US", "name": 42}
+	if err := validateSchema(schema, schema, invalid, "$"); err == nil {
+		t.Error("Expected an invalid document to be rejected")
+	}
+}
+
+func TestTypeScriptDefinitions(t *testing.T) {
+	definitions := TypeScriptDefinitions()
+
+	expected := []string{
+		"export interface CountryData {",
+		"  country_code: string;",
+		"  subdivisions?: Record<string, string>;",
+		"  holidays: Record<string, HolidayDefinition>;",
+		"  updated_at: string;",
+		"export interface HolidayDefinition {",
+		"  weekday_rule?: WeekdayRule;",
+		"  year_range?: YearRange;",
+		"export interface WeekdayRule {",
+		"  weekday: number;",
+	}
+	for _, line := range expected {
+		if !strings.Contains(definitions, line) {
+			t.Errorf("Expected TypeScript definitions to contain %q, got:\n%s", line, definitions)
+		}
+	}
+}
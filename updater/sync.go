@@ -18,6 +18,7 @@ type PythonHolidaysSync struct {
 	repoURL    string
 	dataDir    string
 	httpClient *http.Client
+	notifier   Notifier
 }
 
 // NewPythonHolidaysSync creates a new sync instance
@@ -32,43 +33,18 @@ func NewPythonHolidaysSync(dataDir string) *PythonHolidaysSync {
 	}
 }
 
-// CountryData represents holiday data for a country
-type CountryData struct {
-	CountryCode  string                       `json:"country_code"`
-	Name         string                       `json:"name"`
-	Subdivisions map[string]string            `json:"subdivisions,omitempty"`
-	Categories   []string                     `json:"categories"`
-	Languages    []string                     `json:"languages"`
-	Holidays     map[string]HolidayDefinition `json:"holidays"`
-	UpdatedAt    time.Time                    `json:"updated_at"`
-}
+// CountryData represents holiday data for a country; countries.NewJSONProvider serves
+// its holidays at runtime
+type CountryData = countries.CountryData
 
 // HolidayDefinition represents a holiday definition from Python source
-type HolidayDefinition struct {
-	Name         string            `json:"name"`
-	Category     string            `json:"category"`
-	Languages    map[string]string `json:"languages"`
-	Calculation  string            `json:"calculation"` // "fixed", "easter_based", "weekday_based"
-	Month        int               `json:"month,omitempty"`
-	Day          int               `json:"day,omitempty"`
-	EasterOffset int               `json:"easter_offset,omitempty"`
-	WeekdayRule  *WeekdayRule      `json:"weekday_rule,omitempty"`
-	YearRange    *YearRange        `json:"year_range,omitempty"`
-	Subdivisions []string          `json:"subdivisions,omitempty"`
-}
+type HolidayDefinition = countries.HolidayDefinition
 
 // WeekdayRule defines rules for weekday-based holidays
-type WeekdayRule struct {
-	Month      int          `json:"month"`
-	Weekday    time.Weekday `json:"weekday"`
-	Occurrence int          `json:"occurrence"` // 1=first, 2=second, -1=last
-}
+type WeekdayRule = countries.WeekdayRule
 
 // YearRange defines the valid year range for a holiday
-type YearRange struct {
-	Start int `json:"start,omitempty"`
-	End   int `json:"end,omitempty"`
-}
+type YearRange = countries.YearRange
 
 // SyncData synchronizes holiday data from the Python holidays repository
 func (phs *PythonHolidaysSync) SyncData() error {
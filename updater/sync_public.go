@@ -44,12 +44,34 @@ func (phs *PythonHolidaysSync) SyncAll(ctx context.Context) error {
 	return nil
 }
 
+// SetNotifier configures an optional notifier that receives the result of each country sync
+func (phs *PythonHolidaysSync) SetNotifier(notifier Notifier) {
+	phs.notifier = notifier
+}
+
 // SyncCountry synchronizes a specific country
 func (phs *PythonHolidaysSync) SyncCountry(ctx context.Context, countryCode string) error {
+	result := SyncResult{CountryCode: countryCode, StartedAt: time.Now()}
+
+	err := phs.syncCountry(countryCode, &result)
+
+	result.Duration = time.Since(result.StartedAt)
+	result.Success = err == nil
+	if err != nil {
+		result.Error = err.Error()
+	}
+	phs.notify(ctx, result)
+
+	return err
+}
+
+// syncCountry fetches and saves a country, recording the holiday count in result
+func (phs *PythonHolidaysSync) syncCountry(countryCode string, result *SyncResult) error {
 	countryData, err := phs.fetchCountryData(countryCode)
 	if err != nil {
 		return fmt.Errorf("failed to fetch country data: %w", err)
 	}
+	result.Holidays = len(countryData.Holidays)
 
 	if err := phs.SaveCountryData(countryCode, countryData); err != nil {
 		return fmt.Errorf("failed to save country data: %w", err)
@@ -58,6 +80,20 @@ func (phs *PythonHolidaysSync) SyncCountry(ctx context.Context, countryCode stri
 	return nil
 }
 
+// notify passes a sync result to the configured notifier, if any
+func (phs *PythonHolidaysSync) notify(ctx context.Context, result SyncResult) {
+	if phs.notifier == nil {
+		return
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	if err := phs.notifier.Notify(ctx, result); err != nil {
+		fmt.Printf("Warning: failed to send sync notification for %s: %v\n", result.CountryCode, err)
+	}
+}
+
 // SaveCountryData saves country data to disk
 func (phs *PythonHolidaysSync) SaveCountryData(countryCode string, data *CountryData) error {
 	if countryCode == "" {
@@ -9,6 +9,9 @@ type Syncer interface {
 	// FetchCountryList retrieves the list of available country modules
 	FetchCountryList(ctx context.Context) ([]string, error)
 
+	// FetchCountryListing retrieves the country modules, including files that cannot be mapped to a country code
+	FetchCountryListing(ctx context.Context) (*CountryListing, error)
+
 	// FetchCountryFile retrieves the source file for a specific country
 	FetchCountryFile(ctx context.Context, countryCode string) (string, error)
 
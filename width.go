@@ -0,0 +1,58 @@
+package goholidays
+
+import (
+	"strings"
+	"unicode"
+)
+
+// wideRanges lists the East Asian wide and fullwidth ranges, which take two terminal columns
+var wideRanges = [][2]rune{
+	{0x1100, 0x115F},   // Hangul Jamo initials
+	{0x2E80, 0x303E},   // CJK radicals, Kangxi, CJK symbols and punctuation
+	{0x3041, 0x33FF},   // Hiragana, Katakana, Bopomofo, CJK compatibility
+	{0x3400, 0x4DBF},   // CJK unified ideographs extension A
+	{0x4E00, 0x9FFF},   // CJK unified ideographs
+	{0xA000, 0xA4CF},   // Yi
+	{0xAC00, 0xD7A3},   // Hangul syllables
+	{0xF900, 0xFAFF},   // CJK compatibility ideographs
+	{0xFE30, 0xFE4F},   // CJK compatibility forms
+	{0xFF00, 0xFF60},   // Fullwidth forms
+	{0xFFE0, 0xFFE6},   // Fullwidth signs
+	{0x1F300, 0x1F64F}, // Pictographs and emoticons
+	{0x1F900, 0x1F9FF}, // Supplemental pictographs
+	{0x20000, 0x3FFFD}, // CJK unified ideographs extensions B and beyond
+}
+
+// DisplayWidth returns the number of terminal columns s occupies: East Asian wide and
+// fullwidth characters take two, combining marks and control characters none, and
+// everything else one. fmt pads by rune count, which misaligns columns of names such
+// as "元日" or decomposed accented text.
+func DisplayWidth(s string) int {
+	width := 0
+	for _, r := range s {
+		width += runeWidth(r)
+	}
+	return width
+}
+
+// PadToWidth pads s with spaces on the right to width terminal columns. Strings already
+// as wide are returned unchanged.
+func PadToWidth(s string, width int) string {
+	if padding := width - DisplayWidth(s); padding > 0 {
+		return s + strings.Repeat(" ", padding)
+	}
+	return s
+}
+
+// runeWidth returns the number of terminal columns r occupies
+func runeWidth(r rune) int {
+	if unicode.IsControl(r) || unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf) {
+		return 0
+	}
+	for _, wide := range wideRanges {
+		if r >= wide[0] && r <= wide[1] {
+			return 2
+		}
+	}
+	return 1
+}
@@ -0,0 +1,38 @@
+package goholidays
+
+import "testing"
+
+func TestDisplayWidth(t *testing.T) {
+	tests := []struct {
+		text     string
+		expected int
+	}{
+		{"New Year's Day", 14},
+		{"元日", 4},
+		{"설날", 4},
+		{"Noël", 4},
+		{"Noe\u0308l", 4}, // e followed by a combining diaeresis
+		{"", 0},
+	}
+
+	for _, tt := range tests {
+		if got := DisplayWidth(tt.text); got != tt.expected {
+			t.Errorf("DisplayWidth(%q) = %d, expected %d", tt.text, got, tt.expected)
+		}
+	}
+}
+
+func TestPadToWidth(t *testing.T) {
+	japanese := PadToWidth("元日", 20)
+	english := PadToWidth("New Year's Day", 20)
+	if DisplayWidth(japanese) != 20 || DisplayWidth(english) != 20 {
+		t.Errorf("Expected both names padded to 20 columns, got %d and %d", DisplayWidth(japanese), DisplayWidth(english))
+	}
+	if japanese != "元日                " {
+		t.Errorf("Expected 元日 followed by 16 spaces, got %q", japanese)
+	}
+
+	if got := PadToWidth("National Foundation Day", 10); got != "National Foundation Day" {
+		t.Errorf("Expected a longer name to be left unchanged, got %q", got)
+	}
+}